@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package autoflex contains unit test helpers for resources that use AutoFlex.
+package autoflex
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+var updateIgnoredFields = flag.Bool("update-autoflex-ignored-fields", false, "update AutoFlex field mapping ignore files")
+
+// ignoredFields are the unmapped fields that a field mapping test expects.
+type ignoredFields struct {
+	UnmappedModelFields []string `json:"unmapped_model_fields,omitempty"`
+	UnmappedAPIFields   []string `json:"unmapped_api_fields,omitempty"`
+}
+
+var (
+	unmappedFieldsMu sync.Mutex
+	// unmappedFields are the unmapped fields found by each running test, keyed by test name.
+	unmappedFields = make(map[string]*ignoredFields)
+)
+
+// CheckFieldMappings statically checks how AutoFlex maps the resource model `tfObject` to the
+// AWS API data structures `apiObjects`, e.g. the resource's Create input and Read output types.
+// The test fails if corresponding fields have types that AutoFlex cannot convert between.
+// The test also fails if a model field has no API counterpart or an API field has no model field,
+// unless the field is listed in the test's ignore file, `testdata/autoflex/<test name>.json`,
+// or if the ignore file lists a field that is mapped.
+// Run the test with `-update-autoflex-ignored-fields` to write the ignore file.
+func CheckFieldMappings(t *testing.T, tfObject any, apiObjects []any, optFns ...fwflex.AutoFlexOptionsFunc) {
+	t.Helper()
+
+	report := fwflex.AnalyzeFieldMappings(t.Context(), tfObject, apiObjects, optFns...)
+
+	for _, v := range report.TypeMismatches {
+		t.Errorf("AutoFlex cannot convert between %s", v)
+	}
+
+	// A test may check more than one set of API structures, so the unmapped fields are
+	// compared with the ignore file once the test has finished.
+	unmappedFieldsMu.Lock()
+	defer unmappedFieldsMu.Unlock()
+
+	found, ok := unmappedFields[t.Name()]
+	if !ok {
+		found = &ignoredFields{}
+		unmappedFields[t.Name()] = found
+
+		t.Cleanup(func() {
+			unmappedFieldsMu.Lock()
+			delete(unmappedFields, t.Name())
+			unmappedFieldsMu.Unlock()
+
+			checkIgnoredFields(t, found)
+		})
+	}
+
+	found.UnmappedModelFields = append(found.UnmappedModelFields, report.UnmappedModelFields...)
+	found.UnmappedAPIFields = append(found.UnmappedAPIFields, report.UnmappedAPIFields...)
+}
+
+func checkIgnoredFields(t *testing.T, found *ignoredFields) {
+	t.Helper()
+
+	found.UnmappedModelFields = sortedUnique(found.UnmappedModelFields)
+	found.UnmappedAPIFields = sortedUnique(found.UnmappedAPIFields)
+
+	path := filepath.Join("testdata", "autoflex", t.Name()+".json")
+
+	if *updateIgnoredFields {
+		if err := writeIgnoredFields(path, found); err != nil {
+			t.Fatalf("writing %s: %s", path, err)
+		}
+		return
+	}
+
+	ignored, err := readIgnoredFields(path)
+	if err != nil {
+		t.Fatalf("reading %s: %s", path, err)
+	}
+
+	for _, v := range found.UnmappedModelFields {
+		if !slices.Contains(ignored.UnmappedModelFields, v) {
+			t.Errorf("model field %s has no corresponding API field", v)
+		}
+	}
+	for _, v := range found.UnmappedAPIFields {
+		if !slices.Contains(ignored.UnmappedAPIFields, v) {
+			t.Errorf("API field %s has no corresponding model field", v)
+		}
+	}
+	for _, v := range ignored.UnmappedModelFields {
+		if !slices.Contains(found.UnmappedModelFields, v) {
+			t.Errorf("ignored model field %s has a corresponding API field", v)
+		}
+	}
+	for _, v := range ignored.UnmappedAPIFields {
+		if !slices.Contains(found.UnmappedAPIFields, v) {
+			t.Errorf("ignored API field %s has a corresponding model field", v)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("run the test with -update-autoflex-ignored-fields to update %s", path)
+	}
+}
+
+func readIgnoredFields(path string) (*ignoredFields, error) {
+	var ignored ignoredFields
+
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return &ignored, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &ignored); err != nil {
+		return nil, err
+	}
+
+	return &ignored, nil
+}
+
+func writeIgnoredFields(path string, ignored *ignoredFields) error {
+	if len(ignored.UnmappedModelFields) == 0 && len(ignored.UnmappedAPIFields) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(ignored, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func sortedUnique(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+
+	return slices.Compact(s)
+}
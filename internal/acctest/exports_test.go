@@ -6,4 +6,5 @@ package acctest
 // Exports for use in tests only.
 var (
 	CloseVCRRecorder = closeVCRRecorder
+	StateValueDiffs  = stateValueDiffs
 )
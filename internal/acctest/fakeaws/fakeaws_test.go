@@ -0,0 +1,358 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAWSClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	client := fakeaws.NewServer(t).AWSClient(ctx, t)
+
+	if got, want := client.AccountID(ctx), fakeaws.AccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+	if got, want := client.Region(ctx), fakeaws.Region; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := fakeaws.NewServer(t, fakeaws.NewSQS()).AWSClient(ctx, t, tfsqs.ServicePackage(ctx)).SQSClient(ctx)
+
+	createOutput, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String("test"),
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNameVisibilityTimeout): "60"},
+		Tags:       map[string]string{"k1": "v1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+	queueURL := aws.ToString(createOutput.QueueUrl)
+
+	getOutput, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueURL),
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+	if got, want := getOutput.Attributes[string(sqstypes.QueueAttributeNameVisibilityTimeout)], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := getOutput.Attributes[string(sqstypes.QueueAttributeNameMessageRetentionPeriod)], "345600"; got != want {
+		t.Errorf("MessageRetentionPeriod = %q, want %q", got, want)
+	}
+
+	if _, err := conn.TagQueue(ctx, &sqs.TagQueueInput{QueueUrl: aws.String(queueURL), Tags: map[string]string{"k2": "v2"}}); err != nil {
+		t.Fatalf("TagQueue: %s", err)
+	}
+	if _, err := conn.UntagQueue(ctx, &sqs.UntagQueueInput{QueueUrl: aws.String(queueURL), TagKeys: []string{"k1"}}); err != nil {
+		t.Fatalf("UntagQueue: %s", err)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		t.Fatalf("ListQueueTags: %s", err)
+	}
+	if got, want := len(tagsOutput.Tags), 1; got != want || tagsOutput.Tags["k2"] != "v2" {
+		t.Errorf("Tags = %v, want map[k2:v2]", tagsOutput.Tags)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueAttributes after DeleteQueue: got error %v, want AWS.SimpleQueueService.NonExistentQueue", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := fakeaws.NewServer(t, fakeaws.NewSNS()).AWSClient(ctx, t, tfsns.ServicePackage(ctx)).SNSClient(ctx)
+
+	createOutput, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name:       aws.String("test"),
+		Attributes: map[string]string{"DisplayName": "Test"},
+		Tags:       []snstypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+	topicARN := aws.ToString(createOutput.TopicArn)
+
+	if _, err := conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		TopicArn:       aws.String(topicARN),
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Updated"),
+	}); err != nil {
+		t.Fatalf("SetTopicAttributes: %s", err)
+	}
+
+	getOutput, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: aws.String(topicARN)})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+	if got, want := getOutput.Attributes["DisplayName"], "Updated"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+	if got, want := getOutput.Attributes["TopicArn"], topicARN; got != want {
+		t.Errorf("TopicArn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.UntagResource(ctx, &sns.UntagResourceInput{ResourceArn: aws.String(topicARN), TagKeys: []string{"k1"}}); err != nil {
+		t.Fatalf("UntagResource: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(ctx, &sns.ListTagsForResourceInput{ResourceArn: aws.String(topicARN)})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+	if got := len(tagsOutput.Tags); got != 0 {
+		t.Errorf("len(Tags) = %d, want 0", got)
+	}
+
+	listOutput, err := conn.ListTopics(ctx, &sns.ListTopicsInput{})
+	if err != nil {
+		t.Fatalf("ListTopics: %s", err)
+	}
+	if got, want := len(listOutput.Topics), 1; got != want {
+		t.Errorf("len(Topics) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}); err != nil {
+		t.Fatalf("DeleteTopic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: aws.String(topicARN)})
+	if !errs.IsA[*snstypes.NotFoundException](err) {
+		t.Errorf("GetTopicAttributes after DeleteTopic: got error %v, want NotFoundException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := fakeaws.NewServer(t, fakeaws.NewSSM()).AWSClient(ctx, t, tfssm.ServicePackage(ctx)).SSMClient(ctx)
+
+	for _, name := range []string{"/test/a", "/test/b", "/test/nested/c"} {
+		if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  ssmtypes.ParameterTypeString,
+			Value: aws.String("v1"),
+		}); err != nil {
+			t.Fatalf("PutParameter: %s", err)
+		}
+	}
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/test/a"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("v2"),
+	})
+	if !errs.IsA[*ssmtypes.ParameterAlreadyExists](err) {
+		t.Errorf("PutParameter without Overwrite: got error %v, want ParameterAlreadyExists", err)
+	}
+
+	putOutput, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      aws.String("/test/a"),
+		Overwrite: aws.Bool(true),
+		Type:      ssmtypes.ParameterTypeString,
+		Value:     aws.String("v2"),
+	})
+	if err != nil {
+		t.Fatalf("PutParameter with Overwrite: %s", err)
+	}
+	if got, want := putOutput.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	getOutput, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/test/a")})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+	if got, want := aws.ToString(getOutput.Parameter.Value), "v2"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+
+	pathOutput, err := conn.GetParametersByPath(ctx, &ssm.GetParametersByPathInput{Path: aws.String("/test")})
+	if err != nil {
+		t.Fatalf("GetParametersByPath: %s", err)
+	}
+	if got, want := len(pathOutput.Parameters), 2; got != want {
+		t.Errorf("len(Parameters) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.AddTagsToResource(ctx, &ssm.AddTagsToResourceInput{
+		ResourceId:   aws.String("/test/a"),
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+		Tags:         []ssmtypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	}); err != nil {
+		t.Fatalf("AddTagsToResource: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String("/test/a"),
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+	})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+	if got, want := len(tagsOutput.TagList), 1; got != want {
+		t.Errorf("len(TagList) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String("/test/a")}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/test/a")})
+	if !errs.IsA[*ssmtypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter after DeleteParameter: got error %v, want ParameterNotFound", err)
+	}
+}
+
+func TestKMS(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := fakeaws.NewServer(t, fakeaws.NewKMS()).AWSClient(ctx, t, tfkms.ServicePackage(ctx)).KMSClient(ctx)
+
+	const (
+		aliasName = "alias/test"
+		keyID1    = "11111111-1111-1111-1111-111111111111"
+		keyID2    = "22222222-2222-2222-2222-222222222222"
+	)
+
+	if _, err := conn.CreateAlias(ctx, &kms.CreateAliasInput{AliasName: aws.String(aliasName), TargetKeyId: aws.String(keyID1)}); err != nil {
+		t.Fatalf("CreateAlias: %s", err)
+	}
+
+	_, err := conn.CreateAlias(ctx, &kms.CreateAliasInput{AliasName: aws.String(aliasName), TargetKeyId: aws.String(keyID1)})
+	if !errs.IsA[*kmstypes.AlreadyExistsException](err) {
+		t.Errorf("CreateAlias duplicate: got error %v, want AlreadyExistsException", err)
+	}
+
+	if _, err := conn.UpdateAlias(ctx, &kms.UpdateAliasInput{AliasName: aws.String(aliasName), TargetKeyId: aws.String(keyID2)}); err != nil {
+		t.Fatalf("UpdateAlias: %s", err)
+	}
+
+	listOutput, err := conn.ListAliases(ctx, &kms.ListAliasesInput{KeyId: aws.String(keyID2)})
+	if err != nil {
+		t.Fatalf("ListAliases: %s", err)
+	}
+	if got, want := len(listOutput.Aliases), 1; got != want {
+		t.Fatalf("len(Aliases) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(listOutput.Aliases[0].AliasName), aliasName; got != want {
+		t.Errorf("AliasName = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteAlias(ctx, &kms.DeleteAliasInput{AliasName: aws.String(aliasName)}); err != nil {
+		t.Fatalf("DeleteAlias: %s", err)
+	}
+
+	_, err = conn.DeleteAlias(ctx, &kms.DeleteAliasInput{AliasName: aws.String(aliasName)})
+	if !errs.IsA[*kmstypes.NotFoundException](err) {
+		t.Errorf("DeleteAlias after DeleteAlias: got error %v, want NotFoundException", err)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := fakeaws.NewServer(t, fakeaws.NewIAM()).AWSClient(ctx, t, tfiam.ServicePackage(ctx)).IAMClient(ctx)
+
+	const (
+		roleName = "test"
+		policy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	)
+
+	createOutput, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String(roleName),
+		Tags:                     []iamtypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+	if err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+	if got, want := aws.ToString(createOutput.Role.Arn), "arn:aws:iam::"+fakeaws.AccountID+":role/"+roleName; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.UpdateRole(ctx, &iam.UpdateRoleInput{
+		Description:        aws.String("updated"),
+		MaxSessionDuration: aws.Int32(7200),
+		RoleName:           aws.String(roleName),
+	}); err != nil {
+		t.Fatalf("UpdateRole: %s", err)
+	}
+
+	getOutput, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(getOutput.Role.Description), "updated"; got != want {
+		t.Errorf("Description = %q, want %q", got, want)
+	}
+	if got, want := aws.ToInt32(getOutput.Role.MaxSessionDuration), int32(7200); got != want {
+		t.Errorf("MaxSessionDuration = %d, want %d", got, want)
+	}
+	if got, err := url.QueryUnescape(aws.ToString(getOutput.Role.AssumeRolePolicyDocument)); err != nil || got != policy {
+		t.Errorf("AssumeRolePolicyDocument = %q, want %q", got, policy)
+	}
+	if got, want := len(getOutput.Role.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	policiesOutput, err := conn.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		t.Fatalf("ListAttachedRolePolicies: %s", err)
+	}
+	if got := len(policiesOutput.AttachedPolicies); got != 0 {
+		t.Errorf("len(AttachedPolicies) = %d, want 0", got)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String(roleName)}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if !errs.IsA[*iamtypes.NoSuchEntityException](err) {
+		t.Errorf("GetRole after DeleteRole: got error %v, want NoSuchEntityException", err)
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IAM is a fake AWS Identity and Access Management (IAM) supporting roles and their tags.
+// Roles have no inline or managed policies and are not members of any instance profile.
+type IAM struct {
+	mu     sync.Mutex
+	nextID int
+	roles  map[string]*iamRole // Keyed by role name.
+}
+
+type iamRole struct {
+	assumeRolePolicyDocument string
+	createDate               time.Time
+	description              string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	tags                     map[string]string
+}
+
+// NewIAM returns a new fake IAM service with no roles.
+func NewIAM() *IAM {
+	return &IAM{
+		roles: make(map[string]*iamRole),
+	}
+}
+
+func (*IAM) SigningName() string {
+	return "iam"
+}
+
+func (*IAM) EndpointKey() string {
+	return "iam"
+}
+
+func (s *IAM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "https://iam.amazonaws.com/doc/2010-05-08/", map[string]queryOperation{
+		"CreateRole":                    s.createRole,
+		"DeleteRole":                    s.deleteRole,
+		"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+		"GetRole":                       s.getRole,
+		"ListAttachedRolePolicies":      s.listEmpty("AttachedPolicies"),
+		"ListInstanceProfilesForRole":   s.listEmpty("InstanceProfiles"),
+		"ListRolePolicies":              s.listEmpty("PolicyNames"),
+		"ListRoles":                     s.listRoles,
+		"ListRoleTags":                  s.listRoleTags,
+		"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+		"TagRole":                       s.tagRole,
+		"UntagRole":                     s.untagRole,
+		"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+		"UpdateRole":                    s.updateRole,
+		"UpdateRoleDescription":         s.updateRoleDescription,
+	})
+}
+
+type iamPermissionsBoundaryOutput struct {
+	PermissionsBoundaryARN  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+type iamRoleOutput struct {
+	ARN                      string                        `xml:"Arn"`
+	AssumeRolePolicyDocument string                        `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string                        `xml:"CreateDate"`
+	Description              string                        `xml:"Description,omitempty"`
+	MaxSessionDuration       int                           `xml:"MaxSessionDuration"`
+	Path                     string                        `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundaryOutput `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                        `xml:"RoleId"`
+	RoleName                 string                        `xml:"RoleName"`
+	Tags                     []xmlTag                      `xml:"Tags>member,omitempty"`
+}
+
+func (r *iamRole) output() iamRoleOutput {
+	output := iamRoleOutput{
+		ARN: arn("iam", "", "role"+r.path+r.name),
+		// IAM returns URL-encoded policy documents.
+		AssumeRolePolicyDocument: url.QueryEscape(r.assumeRolePolicyDocument),
+		CreateDate:               r.createDate.UTC().Format(time.RFC3339),
+		Description:              r.description,
+		MaxSessionDuration:       r.maxSessionDuration,
+		Path:                     r.path,
+		RoleID:                   r.roleID,
+		RoleName:                 r.name,
+		Tags:                     xmlTags(r.tags),
+	}
+
+	if r.permissionsBoundary != "" {
+		output.PermissionsBoundary = &iamPermissionsBoundaryOutput{
+			PermissionsBoundaryARN:  r.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return output
+}
+
+// role returns the role with the specified name. The lock must be held.
+func (s *IAM) role(name string) (*iamRole, error) {
+	role, ok := s.roles[name]
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, "NoSuchEntity", "The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+func (s *IAM) createRole(form url.Values) (any, error) {
+	name := form.Get("RoleName")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.roles[name]; ok {
+		return nil, newAPIError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	s.nextID++
+	role := &iamRole{
+		assumeRolePolicyDocument: form.Get("AssumeRolePolicyDocument"),
+		createDate:               time.Now(),
+		description:              form.Get("Description"),
+		maxSessionDuration:       3600, //nolint:mnd // default maximum session duration
+		name:                     name,
+		path:                     "/",
+		permissionsBoundary:      form.Get("PermissionsBoundary"),
+		roleID:                   fmt.Sprintf("AROA%017d", s.nextID),
+		tags:                     queryTags(form, "Tags"),
+	}
+	if v := form.Get("Path"); v != "" {
+		role.path = v
+	}
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		role.maxSessionDuration, _ = strconv.Atoi(v)
+	}
+	s.roles[name] = role
+
+	return struct {
+		Role iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(),
+	}, nil
+}
+
+func (s *IAM) deleteRole(form url.Values) (any, error) {
+	name := form.Get("RoleName")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.role(name); err != nil {
+		return nil, err
+	}
+
+	delete(s.roles, name)
+
+	return nil, nil
+}
+
+func (s *IAM) deleteRolePermissionsBoundary(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *IAM) getRole(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Role iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(),
+	}, nil
+}
+
+// listEmpty returns an operation that validates the role and returns an empty list.
+func (s *IAM) listEmpty(listName string) queryOperation {
+	return func(form url.Values) (any, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, err := s.role(form.Get("RoleName")); err != nil {
+			return nil, err
+		}
+
+		return struct {
+			InnerXML string `xml:",innerxml"`
+		}{
+			InnerXML: fmt.Sprintf("<%[1]s></%[1]s><IsTruncated>false</IsTruncated>", listName),
+		}, nil
+	}
+}
+
+func (s *IAM) listRoles(form url.Values) (any, error) {
+	pathPrefix := form.Get("PathPrefix")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var roles []iamRoleOutput
+	for _, name := range slices.Sorted(maps.Keys(s.roles)) {
+		role := s.roles[name]
+
+		if !strings.HasPrefix(role.path, pathPrefix) {
+			continue
+		}
+
+		output := role.output()
+		// ListRoles does not return tags.
+		output.Tags = nil
+		roles = append(roles, output)
+	}
+
+	return struct {
+		Roles       []iamRoleOutput `xml:"Roles>member"`
+		IsTruncated bool            `xml:"IsTruncated"`
+	}{
+		Roles: roles,
+	}, nil
+}
+
+func (s *IAM) listRoleTags(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Tags        []xmlTag `xml:"Tags>member"`
+		IsTruncated bool     `xml:"IsTruncated"`
+	}{
+		Tags: xmlTags(role.tags),
+	}, nil
+}
+
+func (s *IAM) putRolePermissionsBoundary(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundary = form.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *IAM) tagRole(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(role.tags, queryTags(form, "Tags"), nil)
+
+	return nil, nil
+}
+
+func (s *IAM) untagRole(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(role.tags, nil, queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+func (s *IAM) updateAssumeRolePolicy(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.assumeRolePolicyDocument = form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *IAM) updateRole(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if form.Has("Description") {
+		role.description = form.Get("Description")
+	}
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		role.maxSessionDuration, _ = strconv.Atoi(v)
+	}
+
+	return nil, nil
+}
+
+func (s *IAM) updateRoleDescription(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.description = form.Get("Description")
+
+	return struct {
+		Role iamRoleOutput `xml:"Role"`
+	}{
+		Role: role.output(),
+	}, nil
+}
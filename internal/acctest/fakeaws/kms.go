@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KMS is a fake AWS Key Management Service (KMS) supporting key aliases.
+// Target key IDs are not validated.
+type KMS struct {
+	mu      sync.Mutex
+	aliases map[string]*kmsAlias // Keyed by alias name.
+}
+
+type kmsAlias struct {
+	creationDate    time.Time
+	lastUpdatedDate time.Time
+	targetKeyID     string
+}
+
+// NewKMS returns a new fake KMS service with no aliases.
+func NewKMS() *KMS {
+	return &KMS{
+		aliases: make(map[string]*kmsAlias),
+	}
+}
+
+func (*KMS) SigningName() string {
+	return "kms"
+}
+
+func (*KMS) EndpointKey() string {
+	return "kms"
+}
+
+func (s *KMS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "1.1", map[string]jsonOperation{
+		"CreateAlias": s.createAlias,
+		"DeleteAlias": s.deleteAlias,
+		"ListAliases": s.listAliases,
+		"UpdateAlias": s.updateAlias,
+	})
+}
+
+// kmsKeyID returns the key ID from a key ID or key ARN.
+func kmsKeyID(v string) string {
+	if strings.HasPrefix(v, "arn:") {
+		_, id, _ := strings.Cut(v, ":key/")
+		return id
+	}
+
+	return v
+}
+
+func errKMSAliasNotFound(name string) *apiError {
+	return newAPIError(http.StatusBadRequest, "NotFoundException", "Alias %s is not found.", arn("kms", Region, name))
+}
+
+func (s *KMS) createAlias(body []byte) (any, error) {
+	var input struct {
+		AliasName   string
+		TargetKeyID string `json:"TargetKeyId"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(input.AliasName, "alias/") || strings.HasPrefix(input.AliasName, "alias/aws/") {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidAliasNameException", "Alias %s is not valid.", input.AliasName)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.aliases[input.AliasName]; ok {
+		return nil, newAPIError(http.StatusBadRequest, "AlreadyExistsException", "An alias with the name %s already exists", arn("kms", Region, input.AliasName))
+	}
+
+	now := time.Now()
+	s.aliases[input.AliasName] = &kmsAlias{
+		creationDate:    now,
+		lastUpdatedDate: now,
+		targetKeyID:     kmsKeyID(input.TargetKeyID),
+	}
+
+	return nil, nil
+}
+
+func (s *KMS) deleteAlias(body []byte) (any, error) {
+	var input struct {
+		AliasName string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.aliases[input.AliasName]; !ok {
+		return nil, errKMSAliasNotFound(input.AliasName)
+	}
+
+	delete(s.aliases, input.AliasName)
+
+	return nil, nil
+}
+
+func (s *KMS) listAliases(body []byte) (any, error) {
+	var input struct {
+		KeyID string `json:"KeyId"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	type aliasOutput struct {
+		AliasArn        string
+		AliasName       string
+		CreationDate    float64
+		LastUpdatedDate float64
+		TargetKeyID     string `json:"TargetKeyId"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	aliases := []aliasOutput{}
+	for _, name := range slices.Sorted(maps.Keys(s.aliases)) {
+		alias := s.aliases[name]
+
+		if input.KeyID != "" && alias.targetKeyID != kmsKeyID(input.KeyID) {
+			continue
+		}
+
+		aliases = append(aliases, aliasOutput{
+			AliasArn:        arn("kms", Region, name),
+			AliasName:       name,
+			CreationDate:    epochSeconds(alias.creationDate),
+			LastUpdatedDate: epochSeconds(alias.lastUpdatedDate),
+			TargetKeyID:     alias.targetKeyID,
+		})
+	}
+
+	return map[string]any{
+		"Aliases":   aliases,
+		"Truncated": false,
+	}, nil
+}
+
+func (s *KMS) updateAlias(body []byte) (any, error) {
+	var input struct {
+		AliasName   string
+		TargetKeyID string `json:"TargetKeyId"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	alias, ok := s.aliases[input.AliasName]
+	if !ok {
+		return nil, errKMSAliasNotFound(input.AliasName)
+	}
+
+	alias.lastUpdatedDate = time.Now()
+	alias.targetKeyID = kmsKeyID(input.TargetKeyID)
+
+	return nil, nil
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// apiError is an AWS API error returned by a fake operation.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	// queryCode is the error code of an AWS Query-compatible JSON service, e.g. "AWS.SimpleQueueService.NonExistentQueue".
+	queryCode string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newAPIError(status int, code, format string, a ...any) *apiError {
+	return &apiError{
+		status:  status,
+		code:    code,
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+func errInvalidAction(operation string) *apiError {
+	return newAPIError(http.StatusBadRequest, "InvalidAction", "operation %s is not implemented", operation)
+}
+
+// jsonOperation handles an AWS JSON protocol request body and returns a JSON-marshalable response.
+type jsonOperation func(body []byte) (any, error)
+
+// serveJSON dispatches an AWS JSON 1.0 or 1.1 protocol request to the operation named in the X-Amz-Target header.
+func serveJSON(w http.ResponseWriter, r *http.Request, version string, operations map[string]jsonOperation) {
+	contentType := "application/x-amz-json-" + version
+
+	writeError := func(err error) {
+		e, ok := err.(*apiError)
+		if !ok {
+			e = newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("X-Amzn-RequestId", newRequestID())
+		if e.queryCode != "" {
+			w.Header().Set("X-Amzn-Query-Error", e.queryCode+";Sender")
+		}
+		w.WriteHeader(e.status)
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"__type":  e.code,
+			"message": e.message,
+		})
+	}
+
+	_, operation, _ := strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+	f, ok := operations[operation]
+	if !ok {
+		writeError(errInvalidAction(operation))
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	output, err := f(body)
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-RequestId", newRequestID())
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// decodeJSON unmarshals an AWS JSON protocol request body.
+func decodeJSON(body []byte, v any) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return newAPIError(http.StatusBadRequest, "SerializationException", "%s", err)
+	}
+
+	return nil
+}
+
+// queryOperation handles an AWS Query protocol request and returns an XML-marshalable result, or nil for an empty result.
+type queryOperation func(form url.Values) (any, error)
+
+// serveQuery dispatches an AWS Query protocol request to the operation named in the Action parameter.
+func serveQuery(w http.ResponseWriter, r *http.Request, xmlns string, operations map[string]queryOperation) {
+	requestID := newRequestID()
+
+	writeError := func(err error) {
+		e, ok := err.(*apiError)
+		if !ok {
+			e = newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+		}
+
+		var buf bytes.Buffer
+		buf.WriteString(`<ErrorResponse><Error><Type>Sender</Type>`)
+		fmt.Fprintf(&buf, `<Code>%s</Code><Message>%s</Message>`, xmlEscape(e.code), xmlEscape(e.message))
+		fmt.Fprintf(&buf, `</Error><RequestId>%s</RequestId></ErrorResponse>`, requestID)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(e.status)
+		_, _ = w.Write(buf.Bytes())
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(newAPIError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	action := r.Form.Get("Action")
+	f, ok := operations[action]
+	if !ok {
+		writeError(errInvalidAction(action))
+		return
+	}
+
+	result, err := f(r.Form)
+	if err != nil {
+		writeError(err)
+		return
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<%sResponse xmlns="%s">`, action, xmlns)
+	if result == nil {
+		// Operations with an empty output shape still have a result element.
+		result = struct{}{}
+	}
+	if err := xml.NewEncoder(&buf).EncodeElement(result, xml.StartElement{Name: xml.Name{Local: action + "Result"}}); err != nil {
+		writeError(err)
+		return
+	}
+	fmt.Fprintf(&buf, `<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, requestID, action)
+
+	w.Header().Set("Content-Type", "text/xml")
+	_, _ = w.Write(buf.Bytes())
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+
+	return buf.String()
+}
+
+// queryList returns the members of an AWS Query protocol list parameter, e.g. "TagKeys.member.1".
+func queryList(form url.Values, name string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		k := name + ".member." + strconv.Itoa(i)
+		if !form.Has(k) {
+			return values
+		}
+		values = append(values, form.Get(k))
+	}
+}
+
+// queryMap returns the entries of an AWS Query protocol map parameter, e.g. "Attributes.entry.1.key".
+func queryMap(form url.Values, name string) map[string]string {
+	values := make(map[string]string)
+
+	for i := 1; ; i++ {
+		prefix := name + ".entry." + strconv.Itoa(i) + "."
+		if !form.Has(prefix + "key") {
+			return values
+		}
+		values[form.Get(prefix+"key")] = form.Get(prefix + "value")
+	}
+}
+
+// queryTags returns the tags of an AWS Query protocol list of Key/Value structures, e.g. "Tags.member.1.Key".
+func queryTags(form url.Values, name string) map[string]string {
+	values := make(map[string]string)
+
+	for i := 1; ; i++ {
+		prefix := name + ".member." + strconv.Itoa(i) + "."
+		if !form.Has(prefix + "Key") {
+			return values
+		}
+		values[form.Get(prefix+"Key")] = form.Get(prefix + "Value")
+	}
+}
+
+// xmlTag is a tag in an AWS Query protocol response.
+type xmlTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// xmlEntry is a map entry in an AWS Query protocol response.
+type xmlEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
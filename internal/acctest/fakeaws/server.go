@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements an in-memory stand-in for a subset of AWS APIs.
+//
+// The server is intended for unit testing CRUD handlers, importers, list resources and sweepers
+// without credentials or recorded (VCR) interactions. Requests are routed to a Service by the
+// SigV4 signing name in the request's Authorization header.
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// AccountID is the AWS account ID returned by the fake STS service.
+	AccountID = "123456789012"
+	// Region is the AWS Region used by AWSClient.
+	Region = "us-west-2" //lintignore:AWSAT003
+	// Partition is the AWS partition used in ARNs.
+	Partition = "aws"
+)
+
+// Service is an in-memory implementation of an AWS service's API.
+type Service interface {
+	// SigningName returns the SigV4 signing name of the service, e.g. "sqs".
+	SigningName() string
+	// EndpointKey returns the provider's endpoint configuration key for the service, e.g. "sqs".
+	EndpointKey() string
+	// ServeHTTP handles an API request.
+	ServeHTTP(http.ResponseWriter, *http.Request)
+}
+
+// Server is an HTTP server that serves the APIs of registered in-memory services.
+type Server struct {
+	*httptest.Server
+
+	services map[string]Service
+}
+
+// NewServer starts a new server serving the specified services.
+// A fake STS service answering GetCallerIdentity is always registered.
+// The server is closed when the test completes.
+func NewServer(t *testing.T, services ...Service) *Server {
+	t.Helper()
+
+	s := &Server{
+		services: make(map[string]Service),
+	}
+
+	for _, v := range append([]Service{NewSTS()}, services...) {
+		s.services[v.SigningName()] = v
+	}
+
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+var signingNameRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := signingNameRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		http.Error(w, "missing or invalid SigV4 Authorization header", http.StatusForbidden)
+		return
+	}
+
+	service, ok := s.services[m[1]]
+	if !ok {
+		http.Error(w, fmt.Sprintf("service %q is not implemented", m[1]), http.StatusNotImplemented)
+		return
+	}
+
+	service.ServeHTTP(w, r)
+}
+
+// Endpoints returns the provider endpoint overrides that point each registered service at the server.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.services))
+
+	for _, v := range s.services {
+		endpoints[v.EndpointKey()] = s.URL
+	}
+
+	return endpoints
+}
+
+// AWSClient returns a configured AWSClient whose API clients for the registered services use the server.
+// The service packages whose API clients are used must be passed, e.g. tfsqs.ServicePackage(ctx).
+func (s *Server) AWSClient(ctx context.Context, t *testing.T, servicePackages ...conns.ServicePackage) *conns.AWSClient {
+	t.Helper()
+
+	config := conns.Config{
+		AccessKey:            "mock_access_key",
+		Endpoints:            s.Endpoints(),
+		MaxRetries:           1,
+		Region:               Region,
+		SecretKey:            "mock_secret_key",
+		SkipRegionValidation: true,
+		TerraformVersion:     "1.0.0",
+	}
+
+	client, diags := config.ConfigureProvider(ctx, new(conns.AWSClient))
+	if diags.HasError() {
+		t.Fatalf("configuring AWS client: %v", diags)
+	}
+
+	m := make(map[string]conns.ServicePackage, len(servicePackages))
+	for _, v := range servicePackages {
+		m[v.ServicePackageName()] = v
+	}
+	client.SetServicePackages(ctx, m)
+
+	return client
+}
+
+var requestID atomic.Int64
+
+func newRequestID() string {
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", requestID.Add(1))
+}
+
+func arn(service, region, resource string) string {
+	return strings.Join([]string{"arn", Partition, service, region, AccountID, resource}, ":")
+}
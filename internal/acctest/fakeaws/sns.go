@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SNS is a fake Amazon Simple Notification Service (SNS) supporting topic management and tagging.
+// Topics have no subscriptions.
+type SNS struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by topic ARN.
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSNS returns a new fake SNS service with no topics.
+func NewSNS() *SNS {
+	return &SNS{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+func (*SNS) SigningName() string {
+	return "sns"
+}
+
+func (*SNS) EndpointKey() string {
+	return "sns"
+}
+
+func (s *SNS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "http://sns.amazonaws.com/doc/2010-03-31/", map[string]queryOperation{
+		"CreateTopic":         s.createTopic,
+		"DeleteTopic":         s.deleteTopic,
+		"GetTopicAttributes":  s.getTopicAttributes,
+		"ListTagsForResource": s.listTagsForResource,
+		"ListTopics":          s.listTopics,
+		"SetTopicAttributes":  s.setTopicAttributes,
+		"TagResource":         s.tagResource,
+		"UntagResource":       s.untagResource,
+	})
+}
+
+// topic returns the topic with the specified ARN. The lock must be held.
+func (s *SNS) topic(topicARN string) (*snsTopic, error) {
+	topic, ok := s.topics[topicARN]
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *SNS) createTopic(form url.Values) (any, error) {
+	name := form.Get("Name")
+	topicARN := arn("sns", Region, name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attributes := queryMap(form, "Attributes")
+
+	if topic, ok := s.topics[topicARN]; ok {
+		for k, v := range attributes {
+			if topic.attributes[k] != v {
+				return nil, newAPIError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Attributes Reason: Topic already exists with different attributes")
+			}
+		}
+	} else {
+		topic := &snsTopic{
+			attributes: map[string]string{
+				"DisplayName":             "",
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+				"Owner":                   AccountID,
+				"Policy":                  `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[]}`,
+				"SubscriptionsConfirmed":  "0",
+				"SubscriptionsDeleted":    "0",
+				"SubscriptionsPending":    "0",
+				"TopicArn":                topicARN,
+			},
+			tags: queryTags(form, "Tags"),
+		}
+		if strings.HasSuffix(name, ".fifo") {
+			topic.attributes["FifoTopic"] = "true"
+			topic.attributes["ContentBasedDeduplication"] = "false"
+		}
+		maps.Copy(topic.attributes, attributes)
+		s.topics[topicARN] = topic
+	}
+
+	return struct {
+		TopicARN string `xml:"TopicArn"`
+	}{
+		TopicARN: topicARN,
+	}, nil
+}
+
+func (s *SNS) deleteTopic(form url.Values) (any, error) {
+	topicARN := form.Get("TopicArn")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.topic(topicARN); err != nil {
+		return nil, err
+	}
+
+	delete(s.topics, topicARN)
+
+	return nil, nil
+}
+
+func (s *SNS) getTopicAttributes(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Attributes []xmlEntry `xml:"Attributes>entry"`
+	}{
+		Attributes: xmlEntries(topic.attributes),
+	}, nil
+}
+
+func (s *SNS) listTagsForResource(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Tags []xmlTag `xml:"Tags>member"`
+	}{
+		Tags: xmlTags(topic.tags),
+	}, nil
+}
+
+func (s *SNS) listTopics(url.Values) (any, error) {
+	type topicOutput struct {
+		TopicARN string `xml:"TopicArn"`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var topics []topicOutput
+	for _, topicARN := range slices.Sorted(maps.Keys(s.topics)) {
+		topics = append(topics, topicOutput{TopicARN: topicARN})
+	}
+
+	return struct {
+		Topics []topicOutput `xml:"Topics>member"`
+	}{
+		Topics: topics,
+	}, nil
+}
+
+func (s *SNS) setTopicAttributes(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	topic.attributes[form.Get("AttributeName")] = form.Get("AttributeValue")
+
+	return nil, nil
+}
+
+func (s *SNS) tagResource(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(topic.tags, queryTags(form, "Tags"), nil)
+
+	return nil, nil
+}
+
+func (s *SNS) untagResource(form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	topic, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(topic.tags, nil, queryList(form, "TagKeys"))
+
+	return nil, nil
+}
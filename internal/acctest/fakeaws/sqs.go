@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SQS is a fake Amazon Simple Queue Service (SQS) supporting queue management and tagging.
+type SQS struct {
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by queue name.
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSQS returns a new fake SQS service with no queues.
+func NewSQS() *SQS {
+	return &SQS{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func (*SQS) SigningName() string {
+	return "sqs"
+}
+
+func (*SQS) EndpointKey() string {
+	return "sqs"
+}
+
+func (s *SQS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "1.0", map[string]jsonOperation{
+		"CreateQueue":        s.createQueue,
+		"DeleteQueue":        s.deleteQueue,
+		"GetQueueAttributes": s.getQueueAttributes,
+		"GetQueueUrl":        s.getQueueURL,
+		"ListQueues":         s.listQueues,
+		"ListQueueTags":      s.listQueueTags,
+		"SetQueueAttributes": s.setQueueAttributes,
+		"TagQueue":           s.tagQueue,
+		"UntagQueue":         s.untagQueue,
+	})
+}
+
+func sqsQueueURL(name string) string {
+	return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", Region, AccountID, name)
+}
+
+func errSQSQueueDoesNotExist() *apiError {
+	err := newAPIError(http.StatusBadRequest, "QueueDoesNotExist", "The specified queue does not exist.")
+	err.queryCode = "AWS.SimpleQueueService.NonExistentQueue"
+
+	return err
+}
+
+// queue returns the queue with the specified URL. The lock must be held.
+func (s *SQS) queue(queueURL string) (string, *sqsQueue, error) {
+	name := path.Base(queueURL)
+
+	queue, ok := s.queues[name]
+	if !ok {
+		return "", nil, errSQSQueueDoesNotExist()
+	}
+
+	return name, queue, nil
+}
+
+func (s *SQS) createQueue(body []byte) (any, error) {
+	var input struct {
+		QueueName  string
+		Attributes map[string]string
+		Tags       map[string]string `json:"tags"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if queue, ok := s.queues[input.QueueName]; ok {
+		for k, v := range input.Attributes {
+			if queue.attributes[k] != v {
+				err := newAPIError(http.StatusBadRequest, "QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+				err.queryCode = "QueueAlreadyExists"
+				return nil, err
+			}
+		}
+
+		return map[string]string{"QueueUrl": sqsQueueURL(input.QueueName)}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	attributes := map[string]string{
+		"ApproximateNumberOfMessages":           "0",
+		"ApproximateNumberOfMessagesDelayed":    "0",
+		"ApproximateNumberOfMessagesNotVisible": "0",
+		"CreatedTimestamp":                      now,
+		"DelaySeconds":                          "0",
+		"LastModifiedTimestamp":                 now,
+		"MaximumMessageSize":                    "262144",
+		"MessageRetentionPeriod":                "345600",
+		"QueueArn":                              arn("sqs", Region, input.QueueName),
+		"ReceiveMessageWaitTimeSeconds":         "0",
+		"SqsManagedSseEnabled":                  "true",
+		"VisibilityTimeout":                     "30",
+	}
+	maps.Copy(attributes, input.Attributes)
+
+	tags := make(map[string]string)
+	maps.Copy(tags, input.Tags)
+
+	s.queues[input.QueueName] = &sqsQueue{
+		attributes: attributes,
+		tags:       tags,
+	}
+
+	return map[string]string{"QueueUrl": sqsQueueURL(input.QueueName)}, nil
+}
+
+func (s *SQS) deleteQueue(body []byte) (any, error) {
+	var input struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, _, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, name)
+
+	return nil, nil
+}
+
+func (s *SQS) getQueueAttributes(body []byte) (any, error) {
+	var input struct {
+		QueueURL       string `json:"QueueUrl"`
+		AttributeNames []string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	for k, v := range queue.attributes {
+		if slices.Contains(input.AttributeNames, "All") || slices.Contains(input.AttributeNames, k) {
+			attributes[k] = v
+		}
+	}
+
+	return map[string]any{"Attributes": attributes}, nil
+}
+
+func (s *SQS) getQueueURL(body []byte) (any, error) {
+	var input struct {
+		QueueName string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[input.QueueName]; !ok {
+		return nil, errSQSQueueDoesNotExist()
+	}
+
+	return map[string]string{"QueueUrl": sqsQueueURL(input.QueueName)}, nil
+}
+
+func (s *SQS) listQueues(body []byte) (any, error) {
+	var input struct {
+		QueueNamePrefix string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var urls []string
+	for _, name := range slices.Sorted(maps.Keys(s.queues)) {
+		if strings.HasPrefix(name, input.QueueNamePrefix) {
+			urls = append(urls, sqsQueueURL(name))
+		}
+	}
+
+	return map[string]any{"QueueUrls": urls}, nil
+}
+
+func (s *SQS) listQueueTags(body []byte) (any, error) {
+	var input struct {
+		QueueURL string `json:"QueueUrl"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"Tags": maps.Clone(queue.tags)}, nil
+}
+
+func (s *SQS) setQueueAttributes(body []byte) (any, error) {
+	var input struct {
+		QueueURL   string `json:"QueueUrl"`
+		Attributes map[string]string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(queue.attributes, input.Attributes)
+	queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+func (s *SQS) tagQueue(body []byte) (any, error) {
+	var input struct {
+		QueueURL string `json:"QueueUrl"`
+		Tags     map[string]string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(queue.tags, input.Tags, nil)
+
+	return nil, nil
+}
+
+func (s *SQS) untagQueue(body []byte) (any, error) {
+	var input struct {
+		QueueURL string `json:"QueueUrl"`
+		TagKeys  []string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, queue, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(queue.tags, nil, input.TagKeys)
+
+	return nil, nil
+}
@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SSM is a fake AWS Systems Manager (SSM) supporting Parameter Store parameters and their tags.
+type SSM struct {
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by parameter name.
+}
+
+type ssmParameter struct {
+	allowedPattern   string
+	dataType         string
+	description      string
+	keyID            string
+	lastModifiedDate time.Time
+	name             string
+	tags             map[string]string
+	tier             string
+	typ              string
+	value            string
+	version          int64
+}
+
+// NewSSM returns a new fake SSM service with no parameters.
+func NewSSM() *SSM {
+	return &SSM{
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+func (*SSM) SigningName() string {
+	return "ssm"
+}
+
+func (*SSM) EndpointKey() string {
+	return "ssm"
+}
+
+func (s *SSM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "1.1", map[string]jsonOperation{
+		"AddTagsToResource":      s.addTagsToResource,
+		"DeleteParameter":        s.deleteParameter,
+		"DescribeParameters":     s.describeParameters,
+		"GetParameter":           s.getParameter,
+		"GetParametersByPath":    s.getParametersByPath,
+		"ListTagsForResource":    s.listTagsForResource,
+		"PutParameter":           s.putParameter,
+		"RemoveTagsFromResource": s.removeTagsFromResource,
+	})
+}
+
+type ssmTag struct {
+	Key   string
+	Value string
+}
+
+type ssmParameterOutput struct {
+	ARN              string
+	DataType         string
+	LastModifiedDate float64
+	Name             string
+	Type             string
+	Value            string
+	Version          int64
+}
+
+type ssmParameterMetadataOutput struct {
+	AllowedPattern   string `json:",omitempty"`
+	ARN              string
+	DataType         string
+	Description      string `json:",omitempty"`
+	KeyID            string `json:"KeyId,omitempty"`
+	LastModifiedDate float64
+	Name             string
+	Policies         []any
+	Tier             string
+	Type             string
+	Version          int64
+}
+
+func (p *ssmParameter) arn() string {
+	return arn("ssm", Region, "parameter/"+strings.TrimPrefix(p.name, "/"))
+}
+
+func (p *ssmParameter) output() ssmParameterOutput {
+	return ssmParameterOutput{
+		ARN:              p.arn(),
+		DataType:         p.dataType,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Type:             p.typ,
+		Value:            p.value,
+		Version:          p.version,
+	}
+}
+
+func (p *ssmParameter) metadataOutput() ssmParameterMetadataOutput {
+	return ssmParameterMetadataOutput{
+		AllowedPattern:   p.allowedPattern,
+		ARN:              p.arn(),
+		DataType:         p.dataType,
+		Description:      p.description,
+		KeyID:            p.keyID,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Policies:         []any{},
+		Tier:             p.tier,
+		Type:             p.typ,
+		Version:          p.version,
+	}
+}
+
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000 //nolint:mnd // milliseconds per second
+}
+
+func errSSMParameterNotFound() *apiError {
+	return newAPIError(http.StatusBadRequest, "ParameterNotFound", "Parameter not found.")
+}
+
+// taggedParameter returns the parameter for a tagging operation. The lock must be held.
+func (s *SSM) taggedParameter(resourceType, resourceID string) (*ssmParameter, error) {
+	if resourceType != "Parameter" {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceType", "resource type %s is not implemented", resourceType)
+	}
+
+	parameter, ok := s.parameters[resourceID]
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceId", "The resource ID %s is not valid.", resourceID)
+	}
+
+	return parameter, nil
+}
+
+func (s *SSM) addTagsToResource(body []byte) (any, error) {
+	var input struct {
+		ResourceType string
+		ResourceID   string `json:"ResourceId"`
+		Tags         []ssmTag
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.taggedParameter(input.ResourceType, input.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range input.Tags {
+		parameter.tags[v.Key] = v.Value
+	}
+
+	return nil, nil
+}
+
+func (s *SSM) deleteParameter(body []byte) (any, error) {
+	var input struct {
+		Name string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.parameters[input.Name]; !ok {
+		return nil, errSSMParameterNotFound()
+	}
+
+	delete(s.parameters, input.Name)
+
+	return nil, nil
+}
+
+func (s *SSM) describeParameters(body []byte) (any, error) {
+	var input struct {
+		ParameterFilters []struct {
+			Key    string
+			Option string
+			Values []string
+		}
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameters := []ssmParameterMetadataOutput{}
+	for _, name := range slices.Sorted(maps.Keys(s.parameters)) {
+		match := true
+
+		for _, filter := range input.ParameterFilters {
+			if filter.Key != "Name" {
+				return nil, newAPIError(http.StatusBadRequest, "InvalidFilterKey", "filter key %s is not implemented", filter.Key)
+			}
+
+			switch filter.Option {
+			case "", "Equals":
+				match = match && slices.Contains(filter.Values, name)
+			case "BeginsWith":
+				match = match && slices.ContainsFunc(filter.Values, func(v string) bool {
+					return strings.HasPrefix(name, v)
+				})
+			default:
+				return nil, newAPIError(http.StatusBadRequest, "InvalidFilterOption", "filter option %s is not implemented", filter.Option)
+			}
+		}
+
+		if match {
+			parameters = append(parameters, s.parameters[name].metadataOutput())
+		}
+	}
+
+	return map[string]any{"Parameters": parameters}, nil
+}
+
+func (s *SSM) getParameter(body []byte) (any, error) {
+	var input struct {
+		Name string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, ok := s.parameters[input.Name]
+	if !ok {
+		return nil, errSSMParameterNotFound()
+	}
+
+	return map[string]any{"Parameter": parameter.output()}, nil
+}
+
+func (s *SSM) getParametersByPath(body []byte) (any, error) {
+	var input struct {
+		Path      string
+		Recursive bool
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	path := strings.TrimSuffix(input.Path, "/") + "/"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameters := []ssmParameterOutput{}
+	for _, name := range slices.Sorted(maps.Keys(s.parameters)) {
+		rest, ok := strings.CutPrefix(name, path)
+		if !ok || (!input.Recursive && strings.Contains(rest, "/")) {
+			continue
+		}
+
+		parameters = append(parameters, s.parameters[name].output())
+	}
+
+	return map[string]any{"Parameters": parameters}, nil
+}
+
+func (s *SSM) listTagsForResource(body []byte) (any, error) {
+	var input struct {
+		ResourceType string
+		ResourceID   string `json:"ResourceId"`
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.taggedParameter(input.ResourceType, input.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []ssmTag{}
+	for _, k := range slices.Sorted(maps.Keys(parameter.tags)) {
+		tags = append(tags, ssmTag{Key: k, Value: parameter.tags[k]})
+	}
+
+	return map[string]any{"TagList": tags}, nil
+}
+
+func (s *SSM) putParameter(body []byte) (any, error) {
+	var input struct {
+		AllowedPattern string
+		DataType       string
+		Description    string
+		KeyID          string `json:"KeyId"`
+		Name           string
+		Overwrite      bool
+		Tags           []ssmTag
+		Tier           string
+		Type           string
+		Value          string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, ok := s.parameters[input.Name]
+	if ok {
+		if !input.Overwrite {
+			return nil, newAPIError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+		if len(input.Tags) > 0 {
+			return nil, newAPIError(http.StatusBadRequest, "ValidationException", "Invalid request: tags and overwrite can't be used together.")
+		}
+	} else {
+		parameter = &ssmParameter{
+			dataType: "text",
+			name:     input.Name,
+			tags:     make(map[string]string),
+			tier:     "Standard",
+			typ:      "String",
+		}
+		s.parameters[input.Name] = parameter
+	}
+
+	parameter.allowedPattern = input.AllowedPattern
+	if input.DataType != "" {
+		parameter.dataType = input.DataType
+	}
+	parameter.description = input.Description
+	parameter.keyID = input.KeyID
+	if input.Type == "SecureString" && parameter.keyID == "" {
+		parameter.keyID = "alias/aws/ssm"
+	}
+	parameter.lastModifiedDate = time.Now()
+	for _, v := range input.Tags {
+		parameter.tags[v.Key] = v.Value
+	}
+	if input.Tier != "" && input.Tier != "Intelligent-Tiering" {
+		parameter.tier = input.Tier
+	}
+	if input.Type != "" {
+		parameter.typ = input.Type
+	}
+	parameter.value = input.Value
+	parameter.version++
+
+	return map[string]any{
+		"Tier":    parameter.tier,
+		"Version": parameter.version,
+	}, nil
+}
+
+func (s *SSM) removeTagsFromResource(body []byte) (any, error) {
+	var input struct {
+		ResourceType string
+		ResourceID   string `json:"ResourceId"`
+		TagKeys      []string
+	}
+	if err := decodeJSON(body, &input); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parameter, err := s.taggedParameter(input.ResourceType, input.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(parameter.tags, nil, input.TagKeys)
+
+	return nil, nil
+}
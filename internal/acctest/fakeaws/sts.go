@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// STS is a fake AWS Security Token Service (STS) that answers GetCallerIdentity.
+type STS struct{}
+
+// NewSTS returns a new fake STS service.
+func NewSTS() *STS {
+	return &STS{}
+}
+
+func (*STS) SigningName() string {
+	return "sts"
+}
+
+func (*STS) EndpointKey() string {
+	return "sts"
+}
+
+func (s *STS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "https://sts.amazonaws.com/doc/2011-06-15/", map[string]queryOperation{
+		"GetCallerIdentity": s.getCallerIdentity,
+	})
+}
+
+func (*STS) getCallerIdentity(url.Values) (any, error) {
+	return struct {
+		Account string `xml:"Account"`
+		Arn     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	}{
+		Account: AccountID,
+		Arn:     arn("iam", "", "user/fakeaws"),
+		UserID:  "AIDAFAKEAWS",
+	}, nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"slices"
+)
+
+func xmlTags(tags map[string]string) []xmlTag {
+	var v []xmlTag
+
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		v = append(v, xmlTag{Key: k, Value: tags[k]})
+	}
+
+	return v
+}
+
+func xmlEntries(m map[string]string) []xmlEntry {
+	var v []xmlEntry
+
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		v = append(v, xmlEntry{Key: k, Value: m[k]})
+	}
+
+	return v
+}
+
+func updateTags(tags map[string]string, add map[string]string, remove []string) {
+	maps.Copy(tags, add)
+
+	for _, k := range remove {
+		delete(tags, k)
+	}
+}
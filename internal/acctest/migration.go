@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+// ExternalProviderAWS returns the ExternalProviders for a test step that uses a released version of the AWS provider.
+// If version is empty the latest released version is used.
+func ExternalProviderAWS(version string) map[string]resource.ExternalProvider {
+	return map[string]resource.ExternalProvider{
+		"aws": {
+			Source:            "hashicorp/aws",
+			VersionConstraint: version,
+		},
+	}
+}
+
+// MigrationEquivalenceTestSteps returns test steps that verify that migrating a resource from Terraform Plugin SDK V2
+// to Terraform Plugin Framework does not change existing resources.
+//
+// The previous step must create the resource using the last released provider version implemented with Plugin SDK V2,
+// either by setting ExternalProviders (see ExternalProviderAWS) or by using a configuration that pins the provider version.
+// The current step is run with the in-tree provider and must use an equivalent configuration.
+// It expects an empty plan before and after apply and that every state value of the resource is unchanged,
+// so that differences such as null vs. empty string or a change in element ordering are reported.
+func MigrationEquivalenceTestSteps(resourceName string, previous, current resource.TestStep) []resource.TestStep {
+	state := &resourceState{}
+
+	previous.ConfigStateChecks = append(previous.ConfigStateChecks, getResourceState(resourceName, state))
+
+	if current.ProtoV5ProviderFactories == nil && current.ProtoV6ProviderFactories == nil && current.ExternalProviders == nil {
+		current.ProtoV5ProviderFactories = ProtoV5ProviderFactories
+	}
+	current.ConfigPlanChecks.PreApply = append(current.ConfigPlanChecks.PreApply, plancheck.ExpectEmptyPlan())
+	current.ConfigPlanChecks.PostApplyPostRefresh = append(current.ConfigPlanChecks.PostApplyPostRefresh, plancheck.ExpectEmptyPlan())
+	current.ConfigStateChecks = append(current.ConfigStateChecks, expectResourceStateEquivalent(resourceName, state))
+
+	return []resource.TestStep{previous, current}
+}
+
+type resourceState struct {
+	values map[string]any
+}
+
+type getResourceStateCheck struct {
+	resourceAddress string
+	state           *resourceState
+}
+
+func getResourceState(resourceAddress string, state *resourceState) statecheck.StateCheck {
+	return getResourceStateCheck{
+		resourceAddress: resourceAddress,
+		state:           state,
+	}
+}
+
+func (c getResourceStateCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	values, err := resourceAttributeValues(request, c.resourceAddress)
+	if err != nil {
+		response.Error = err
+		return
+	}
+
+	c.state.values = maps.Clone(values)
+}
+
+type expectResourceStateEquivalentCheck struct {
+	resourceAddress string
+	state           *resourceState
+}
+
+func expectResourceStateEquivalent(resourceAddress string, state *resourceState) statecheck.StateCheck {
+	return expectResourceStateEquivalentCheck{
+		resourceAddress: resourceAddress,
+		state:           state,
+	}
+}
+
+func (c expectResourceStateEquivalentCheck) CheckState(ctx context.Context, request statecheck.CheckStateRequest, response *statecheck.CheckStateResponse) {
+	if c.state.values == nil {
+		response.Error = fmt.Errorf("%s - previous state values have not been set", c.resourceAddress)
+		return
+	}
+
+	values, err := resourceAttributeValues(request, c.resourceAddress)
+	if err != nil {
+		response.Error = err
+		return
+	}
+
+	if diffs := stateValueDiffs("", c.state.values, values); len(diffs) > 0 {
+		response.Error = fmt.Errorf("%s - state values changed after migration:\n%s", c.resourceAddress, strings.Join(diffs, "\n"))
+	}
+}
+
+func resourceAttributeValues(request statecheck.CheckStateRequest, resourceAddress string) (map[string]any, error) {
+	if request.State == nil || request.State.Values == nil || request.State.Values.RootModule == nil {
+		return nil, errors.New("state does not contain a root module")
+	}
+
+	for _, r := range request.State.Values.RootModule.Resources {
+		if r.Address == resourceAddress {
+			return r.AttributeValues, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s - Resource not found in state", resourceAddress)
+}
+
+// stateValueDiffs returns a description of each difference between two state values.
+// The differences are returned in attribute path order.
+func stateValueDiffs(path string, old, new any) []string {
+	if reflect.DeepEqual(old, new) {
+		return nil
+	}
+
+	switch old := old.(type) {
+	case map[string]any:
+		if new, ok := new.(map[string]any); ok {
+			var diffs []string
+
+			keys := slices.Collect(maps.Keys(old))
+			for k := range new {
+				if _, ok := old[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			slices.Sort(keys)
+
+			for _, k := range keys {
+				diffs = append(diffs, stateValueDiffs(joinStatePath(path, k), old[k], new[k])...)
+			}
+
+			return diffs
+		}
+
+	case []any:
+		if new, ok := new.([]any); ok {
+			if len(old) == len(new) && sameElements(old, new) {
+				return []string{fmt.Sprintf("%s: element order changed", statePathOrRoot(path))}
+			}
+
+			if len(old) != len(new) {
+				return []string{fmt.Sprintf("%s: %d elements changed to %d elements", statePathOrRoot(path), len(old), len(new))}
+			}
+
+			var diffs []string
+
+			for i := range old {
+				diffs = append(diffs, stateValueDiffs(joinStatePath(path, strconv.Itoa(i)), old[i], new[i])...)
+			}
+
+			return diffs
+		}
+	}
+
+	switch {
+	case old == nil && new == "":
+		return []string{fmt.Sprintf("%s: null changed to empty string", statePathOrRoot(path))}
+	case old == "" && new == nil:
+		return []string{fmt.Sprintf("%s: empty string changed to null", statePathOrRoot(path))}
+	default:
+		return []string{fmt.Sprintf("%s: %#v changed to %#v", statePathOrRoot(path), old, new)}
+	}
+}
+
+// sameElements returns whether two equal-length slices contain the same elements, ignoring order.
+func sameElements(old, new []any) bool {
+	matched := make([]bool, len(new))
+
+	for _, o := range old {
+		found := false
+
+		for i, n := range new {
+			if !matched[i] && reflect.DeepEqual(o, n) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func joinStatePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func statePathOrRoot(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return path
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestStateValueDiffs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      map[string]any
+		new      map[string]any
+		expected []string
+	}{
+		"equal": {
+			old: map[string]any{
+				"name": "test",
+				"list": []any{"a", "b"},
+			},
+			new: map[string]any{
+				"name": "test",
+				"list": []any{"a", "b"},
+			},
+		},
+		"null to empty string": {
+			old: map[string]any{
+				"description": nil,
+			},
+			new: map[string]any{
+				"description": "",
+			},
+			expected: []string{
+				"description: null changed to empty string",
+			},
+		},
+		"empty string to null": {
+			old: map[string]any{
+				"nested": []any{
+					map[string]any{
+						"value": "",
+					},
+				},
+			},
+			new: map[string]any{
+				"nested": []any{
+					map[string]any{
+						"value": nil,
+					},
+				},
+			},
+			expected: []string{
+				"nested.0.value: empty string changed to null",
+			},
+		},
+		"element order": {
+			old: map[string]any{
+				"set": []any{"a", "b", "c"},
+			},
+			new: map[string]any{
+				"set": []any{"c", "a", "b"},
+			},
+			expected: []string{
+				"set: element order changed",
+			},
+		},
+		"element count": {
+			old: map[string]any{
+				"list": []any{"a"},
+			},
+			new: map[string]any{
+				"list": []any{},
+			},
+			expected: []string{
+				"list: 1 elements changed to 0 elements",
+			},
+		},
+		"added and removed attributes": {
+			old: map[string]any{
+				"removed": "x",
+			},
+			new: map[string]any{
+				"added": true,
+			},
+			expected: []string{
+				"added: <nil> changed to true",
+				`removed: "x" changed to <nil>`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.StateValueDiffs("", testCase.old, testCase.new)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -10,13 +10,16 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -29,15 +32,20 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	allowedAccountIDs         []string                           // From provider configuration.
+	assumeRoleCredentials     map[string]aws.CredentialsProvider // Role ARN -> cached credentials.
+	assumeRoleLock            sync.Mutex
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any // Region (and any assumed role) -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenAccountIDs       []string          // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	preventDestroyConfig      *tftags.PreventDestroyConfig
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -45,6 +53,7 @@ type AWSClient struct {
 	stsRegion                 string // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
 	terraformVersion          string // From provider configuration.
+	warnOnUnknownReplacements bool   // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -67,11 +76,21 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 	return c.terraformVersion
 }
 
+// WarnOnUnknownReplacements returns whether to warn of resource replacements caused by values unknown at plan time.
+func (c *AWSClient) WarnOnUnknownReplacements(_ context.Context) bool {
+	return c.warnOnUnknownReplacements
+}
+
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
+// If the currently in-process operation has defined a per-resource assume role override,
+// credentials for that role are returned.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
 		return nil
 	}
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		return c.assumeRoleCredentialsProvider(ctx, roleARN)
+	}
 	return c.awsConfig.Credentials
 }
 
@@ -83,16 +102,35 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+func (c *AWSClient) PreventDestroyConfig(context.Context) *tftags.PreventDestroyConfig {
+	return c.preventDestroyConfig
+}
+
 func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// If the currently in-process operation has defined a per-resource assume role override,
+// the configuration uses credentials for that role.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		cfg.Credentials = c.assumeRoleCredentialsProvider(ctx, roleARN)
+	}
+	return cfg
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource assume role override,
+// the role's account ID is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return v.AccountID
+		}
+	}
+
 	return c.accountID
 }
 
@@ -180,6 +218,16 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	if overrideAssumeRoleARN(ctx) != "" {
+		// Only the provider's default credentials S3 Express client is cached.
+		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -332,14 +380,108 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
+// ValidateInContextAssumeRoleARN verifies that the value of the top-level `assume_role_arn` attribute is an IAM role ARN
+// in the configured AWS partition and that the role's account is allowed by the provider configuration.
+func (c *AWSClient) ValidateInContextAssumeRoleARN(ctx context.Context) error {
+	roleARN := overrideAssumeRoleARN(ctx)
+	if roleARN == "" {
+		return nil
+	}
+
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return fmt.Errorf("per-resource assume role (%s) is not a valid ARN: %w", roleARN, err)
+	}
+
+	if v.Service != "iam" || !strings.HasPrefix(v.Resource, "role/") {
+		return fmt.Errorf("per-resource assume role (%s) is not an IAM role ARN", roleARN)
+	}
+
+	if got, want := v.Partition, c.Partition(ctx); want != "" && got != want {
+		return fmt.Errorf("partition (%s) for per-resource assume role (%s) is not the provider's configured partition (%s)", got, roleARN, want)
+	}
+
+	if accountID := v.AccountID; len(c.allowedAccountIDs) > 0 && !slices.Contains(c.allowedAccountIDs, accountID) {
+		return fmt.Errorf("AWS account ID (%s) for per-resource assume role (%s) is not allowed", accountID, roleARN)
+	} else if slices.Contains(c.forbiddenAccountIDs, accountID) {
+		return fmt.Errorf("AWS account ID (%s) for per-resource assume role (%s) is forbidden", accountID, roleARN)
+	}
+
+	return nil
+}
+
+// assumeRoleCredentialsProvider returns cached credentials for the specified IAM role.
+// The role is assumed using the provider's configured credentials.
+func (c *AWSClient) assumeRoleCredentialsProvider(ctx context.Context, roleARN string) aws.CredentialsProvider {
+	c.assumeRoleLock.Lock()
+	defer c.assumeRoleLock.Unlock()
+
+	if v, ok := c.assumeRoleCredentials[roleARN]; ok {
+		return v
+	}
+
+	tflog.Debug(ctx, "Configuring per-resource assume role", map[string]any{
+		"tf_aws.assume_role_arn": roleARN,
+	})
+
+	stsClient := sts.NewFromConfig(c.awsConfig.Copy(), func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	credentials := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, roleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = assumeRoleSessionName
+	}))
+
+	if c.assumeRoleCredentials == nil {
+		c.assumeRoleCredentials = make(map[string]aws.CredentialsProvider)
+	}
+	c.assumeRoleCredentials[roleARN] = credentials
+
+	return credentials
+}
+
+// clientCacheKey returns the key under which default API clients are cached.
+func (c *AWSClient) clientCacheKey(ctx context.Context) string {
+	key := c.Region(ctx)
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		key += "|" + roleARN
+	}
+
+	return key
+}
+
+// overrideAssumeRoleARN returns any currently in effect per-resource assume role override.
+func overrideAssumeRoleARN(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRoleARN()
+	}
+
+	return ""
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
 
+const (
+	assumeRoleSessionName = "terraform-provider-aws"
+)
+
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if roleARN := overrideAssumeRoleARN(ctx); roleARN != "" {
+		cfg := c.awsConfig.Copy()
+		cfg.Credentials = c.assumeRoleCredentialsProvider(ctx, roleARN)
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -365,7 +507,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	key := c.clientCacheKey(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -373,7 +515,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -407,10 +549,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
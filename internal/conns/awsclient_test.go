@@ -253,6 +253,132 @@ func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgre
 	}
 }
 
+func TestAWSClientValidateInContextAssumeRoleARN(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		RoleARN   string
+		Expected  bool
+	}{
+		{
+			Name:      "No override",
+			AWSClient: &AWSClient{},
+			Expected:  true,
+		},
+		{
+			Name: "Valid role",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: true,
+		},
+		{
+			Name: "Not a role",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:user/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+		{
+			Name: "Invalid ARN",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+			},
+			RoleARN:  "spoke",
+			Expected: false,
+		},
+		{
+			Name: "Wrong partition",
+			AWSClient: &AWSClient{
+				partition: chinaPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+		{
+			Name: "Allowed account",
+			AWSClient: &AWSClient{
+				allowedAccountIDs: []string{"111111111111", "123456789012"},
+				partition:         standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: true,
+		},
+		{
+			Name: "Not allowed account",
+			AWSClient: &AWSClient{
+				allowedAccountIDs: []string{"111111111111"},
+				partition:         standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+		{
+			Name: "Forbidden account",
+			AWSClient: &AWSClient{
+				forbiddenAccountIDs: []string{"123456789012"},
+				partition:           standardPartition,
+			},
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithOverrideAssumeRoleARN(NewResourceContext(ctx, "test", "Test", "aws_test_test", ""), testCase.RoleARN)
+			err := testCase.AWSClient.ValidateInContextAssumeRoleARN(ctx)
+
+			if got := err == nil; got != testCase.Expected {
+				t.Errorf("got %t, expected %t: %v", got, testCase.Expected, err)
+			}
+		})
+	}
+}
+
+func TestAWSClientAccountID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	testCases := []struct {
+		Name     string
+		RoleARN  string
+		Expected string
+	}{
+		{
+			Name:     "No override",
+			Expected: "111111111111",
+		},
+		{
+			Name:     "Override",
+			RoleARN:  "arn:aws:iam::123456789012:role/spoke", //lintignore:AWSAT005
+			Expected: "123456789012",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				accountID: "111111111111",
+			}
+			ctx := WithOverrideAssumeRoleARN(NewResourceContext(ctx, "test", "Test", "aws_test_test", ""), testCase.RoleARN)
+
+			if got, want := client.AccountID(ctx), testCase.Expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
 func TestAWSClientGlobalARN(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
@@ -44,6 +44,7 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PreventDestroyConfig           *tftags.PreventDestroyConfig
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
@@ -64,6 +65,7 @@ type Config struct {
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 	UserAgent                      awsbase.UserAgentProducts
+	WarnOnUnknownReplacements      bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -211,10 +213,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.allowedAccountIDs = c.AllowedAccountIds
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.forbiddenAccountIDs = c.ForbiddenAccountIds
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.preventDestroyConfig = c.PreventDestroyConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
+	client.warnOnUnknownReplacements = c.WarnOnUnknownReplacements
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
@@ -55,11 +55,17 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	typeName           string // Resource type name, e.g. "aws_iam_role"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRoleARN string // Any currently in effect per-resource assume role override.
+	overrideRegion        string // Any currently in effect per-resource Region override.
+	resourceName          string // Friendly resource name, e.g. "Subnet"
+	typeName              string // Resource type name, e.g. "aws_iam_role"
+	servicePackageName    string // Canonical name defined as a constant in names package
+	vcrEnabled            bool   // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRoleARN returns any currently in effect per-resource assume role override.
+func (c *InContext) OverrideAssumeRoleARN() string {
+	return c.overrideAssumeRoleARN
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -99,6 +105,19 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithOverrideAssumeRoleARN returns a copy of the resource Context with the specified per-resource assume role override.
+func WithOverrideAssumeRoleARN(ctx context.Context, roleARN string) context.Context {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	v := *inContext
+	v.overrideAssumeRoleARN = roleARN
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
@@ -16,3 +16,8 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetPreventDestroyConfig is only intended for use in tests
+func SetPreventDestroyConfig(client *AWSClient, p *tftags.PreventDestroyConfig) {
+	client.preventDestroyConfig = p
+}
@@ -4,13 +4,26 @@
 package flex
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
 
+// AttrWriteOnlyVersion is the name of the computed attribute that is incremented whenever the value of a write-only attribute changes.
+const AttrWriteOnlyVersion = "write_only_version"
+
 type writeOnlyAttrGetter interface {
 	Get(string) any
 	GetRawConfig() cty.Value
@@ -60,3 +73,212 @@ func HasWriteOnlyValue(d writeOnlyAttrGetter, attr string) bool {
 
 	return d.Get(hasAttr).(bool)
 }
+
+// WriteOnlyVersionSchema returns the schema of the computed attribute that is incremented whenever the value of a write-only attribute changes.
+// Write-only attribute values are always null in the plan and Terraform only plans an update if a planned value changes.
+// The attribute holds no data derived from the write-only values; keyed hashes of the values are stored in private state.
+func WriteOnlyVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+}
+
+// WriteOnlyValuesCustomizeDiff returns a CustomizeDiff function that plans an update if the configured value of any of the specified write-only string attributes changes.
+func WriteOnlyValuesCustomizeDiff(paths ...cty.Path) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+		// The version is unknown on creation.
+		if d.Id() == "" {
+			return nil
+		}
+
+		private, ok := sdkv2.PrivateStateFromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		stored, err := getWriteOnlyValueHashes(private)
+		if err != nil {
+			return err
+		}
+
+		for _, path := range paths {
+			if changed, known := stored.changed(d.GetRawConfig(), path); changed || !known {
+				return d.SetNewComputed(AttrWriteOnlyVersion)
+			}
+		}
+
+		return nil
+	}
+}
+
+// SetWriteOnlyValues stores keyed hashes of the specified write-only string attributes' configured values in private state
+// and increments the version if any value has changed.
+// It must be called from a resource's Create and Update handlers with the same paths as passed to WriteOnlyValuesCustomizeDiff.
+func SetWriteOnlyValues(ctx context.Context, d *schema.ResourceData, paths ...cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The planned version is unknown if a change was detected.
+	o, _ := d.GetChange(AttrWriteOnlyVersion)
+	version := o.(int)
+	changed := d.IsNewResource() || version == 0
+
+	if private, ok := sdkv2.PrivateStateFromContext(ctx); ok {
+		stored, err := getWriteOnlyValueHashes(private)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if len(stored.Key) == 0 {
+			stored.Key = make([]byte, 32)
+			if _, err := rand.Read(stored.Key); err != nil {
+				return sdkdiag.AppendErrorf(diags, "generating write-only value hash key: %s", err)
+			}
+		}
+
+		hashes := make(map[string]string)
+		for _, path := range paths {
+			if v, _ := writeOnlyStringValue(d.GetRawConfig(), path); v != nil {
+				hashes[writeOnlyValueKey(path)] = stored.hash(*v)
+			}
+		}
+
+		if !maps.Equal(stored.Hashes, hashes) {
+			changed = true
+			stored.Hashes = hashes
+
+			if err := setWriteOnlyValueHashes(private, stored); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if changed {
+		version++
+	}
+
+	if err := d.Set(AttrWriteOnlyVersion, version); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting %s: %s", AttrWriteOnlyVersion, err)
+	}
+
+	return diags
+}
+
+// HasWriteOnlyValueChange returns true if the configured value of the specified write-only string attribute has changed.
+// It must only be called from a resource's Update handler, before SetWriteOnlyValues.
+func HasWriteOnlyValueChange(ctx context.Context, d *schema.ResourceData, path cty.Path) bool {
+	private, ok := sdkv2.PrivateStateFromContext(ctx)
+	if !ok {
+		return d.HasChange(AttrWriteOnlyVersion)
+	}
+
+	stored, err := getWriteOnlyValueHashes(private)
+	if err != nil {
+		return true
+	}
+
+	changed, _ := stored.changed(d.GetRawConfig(), path)
+
+	return changed
+}
+
+// writeOnlyValueHashesPrivateStateKey is the private state key under which write-only value hashes are stored.
+const writeOnlyValueHashesPrivateStateKey = "write_only_value_hashes"
+
+// writeOnlyValueHashes are HMAC-SHA256 hashes of write-only attribute values, keyed by attribute path.
+// The random HMAC key is generated per resource instance so that equal values in different resources have different hashes.
+type writeOnlyValueHashes struct {
+	Key    []byte            `json:"key"`
+	Hashes map[string]string `json:"hashes"`
+}
+
+func getWriteOnlyValueHashes(private *sdkv2.PrivateState) (*writeOnlyValueHashes, error) {
+	var v writeOnlyValueHashes
+
+	if b := private.GetKey(writeOnlyValueHashesPrivateStateKey); len(b) > 0 {
+		if err := tfjson.DecodeFromBytes(b, &v); err != nil {
+			return nil, fmt.Errorf("decoding write-only value hashes from private state: %w", err)
+		}
+	}
+
+	return &v, nil
+}
+
+func setWriteOnlyValueHashes(private *sdkv2.PrivateState, v *writeOnlyValueHashes) error {
+	b, err := tfjson.EncodeToBytes(v)
+	if err != nil {
+		return fmt.Errorf("encoding write-only value hashes to private state: %w", err)
+	}
+
+	private.SetKey(writeOnlyValueHashesPrivateStateKey, b)
+
+	return nil
+}
+
+func (v *writeOnlyValueHashes) hash(value string) string {
+	mac := hmac.New(sha256.New, v.Key)
+	mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// changed returns whether the configured value of the specified write-only string attribute differs from the stored value.
+// If the value is unknown, known is false.
+func (v *writeOnlyValueHashes) changed(config cty.Value, path cty.Path) (bool, bool) {
+	value, known := writeOnlyStringValue(config, path)
+	if !known {
+		return false, false
+	}
+
+	hash, ok := v.Hashes[writeOnlyValueKey(path)]
+	if value == nil {
+		return ok, true
+	}
+
+	return !ok || !hmac.Equal([]byte(hash), []byte(v.hash(*value))), true
+}
+
+// writeOnlyStringValue returns the value of the specified write-only string attribute in the raw configuration.
+// A null or missing value, e.g. in an unconfigured block, is returned as nil.
+// If the value is unknown, known is false.
+func writeOnlyStringValue(config cty.Value, path cty.Path) (*string, bool) {
+	if config.IsNull() {
+		return nil, true
+	}
+
+	v, err := path.Apply(config)
+	if err != nil || v.IsNull() {
+		return nil, true
+	}
+
+	if !v.IsKnown() {
+		return nil, false
+	}
+
+	if !v.Type().Equals(cty.String) {
+		return nil, true
+	}
+
+	s := v.AsString()
+
+	return &s, true
+}
+
+func writeOnlyValueKey(path cty.Path) string {
+	var parts []string
+
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, step.Name)
+		case cty.IndexStep:
+			if step.Key.Type() == cty.Number {
+				parts = append(parts, step.Key.AsBigFloat().Text('f', 0))
+			} else {
+				parts = append(parts, step.Key.AsString())
+			}
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
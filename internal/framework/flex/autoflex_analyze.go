@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// FieldMappingReport is the result of statically analyzing how AutoFlex maps a resource model
+// to one or more AWS API data structures.
+// Each entry is a dotted field path, e.g. `Containers.PortMapping` or `CreateQueueInput.Attributes`.
+type FieldMappingReport struct {
+	// UnmappedModelFields are model fields that have no corresponding field in any of the API structures.
+	UnmappedModelFields []string
+	// UnmappedAPIFields are API structure fields that have no corresponding model field.
+	// Fields ignored via WithIgnoredFieldNames are not reported.
+	UnmappedAPIFields []string
+	// TypeMismatches are corresponding fields whose types AutoFlex cannot convert between.
+	TypeMismatches []string
+}
+
+// IsEmpty returns whether the report contains no findings.
+func (r FieldMappingReport) IsEmpty() bool {
+	return len(r.UnmappedModelFields) == 0 && len(r.UnmappedAPIFields) == 0 && len(r.TypeMismatches) == 0
+}
+
+var (
+	// unreportedModelAttributes are the top-level model attributes that are handled by the provider
+	// and are not expected to have a corresponding API field.
+	unreportedModelAttributes = []string{
+		"id",
+		"region",
+		"tags_all",
+		"timeouts",
+	}
+	// unreportedAPIFieldNames are API structure fields that are not expected to have a corresponding model field.
+	unreportedAPIFieldNames = []string{
+		"ClientToken",
+		"ResultMetadata",
+	}
+)
+
+// AnalyzeFieldMappings walks the types of the resource model `tfObject` and the AWS API data structures
+// `apiObjects` (typically the resource's Create input and Read output types), matching fields exactly
+// as Expand and Flatten would, and reports the fields that AutoFlex would silently skip.
+// Nested objects are analyzed recursively. Values are not inspected, so zero values may be passed.
+func AnalyzeFieldMappings(ctx context.Context, tfObject any, apiObjects []any, optFns ...AutoFlexOptionsFunc) FieldMappingReport {
+	a := &fieldMappingAnalyzer{
+		expander:  newAutoExpander(optFns),
+		flattener: newAutoFlattener(optFns),
+		seen:      make(map[reflect.Type]bool),
+	}
+
+	var typesTo []reflect.Type
+	for _, v := range apiObjects {
+		if typ := structType(reflect.TypeOf(v)); typ != nil {
+			typesTo = append(typesTo, typ)
+		}
+	}
+
+	if typeFrom := structType(reflect.TypeOf(tfObject)); typeFrom != nil {
+		a.analyzeStruct(ctx, "", typeFrom, typesTo)
+	}
+
+	slices.Sort(a.report.UnmappedModelFields)
+	slices.Sort(a.report.UnmappedAPIFields)
+	slices.Sort(a.report.TypeMismatches)
+	a.report.UnmappedModelFields = slices.Compact(a.report.UnmappedModelFields)
+	a.report.UnmappedAPIFields = slices.Compact(a.report.UnmappedAPIFields)
+	a.report.TypeMismatches = slices.Compact(a.report.TypeMismatches)
+
+	return a.report
+}
+
+type fieldMappingAnalyzer struct {
+	expander  autoFlexer
+	flattener autoFlexer
+	report    FieldMappingReport
+	// seen records the model struct types already analyzed, to terminate recursive types.
+	seen map[reflect.Type]bool
+}
+
+// analyzeStruct analyzes the mapping between model struct type `typeFrom` and the API struct types `typesTo`.
+func (a *fieldMappingAnalyzer) analyzeStruct(ctx context.Context, modelPath string, typeFrom reflect.Type, typesTo []reflect.Type) {
+	if a.seen[typeFrom] {
+		return
+	}
+	a.seen[typeFrom] = true
+
+	// Types that customize their conversion are opaque.
+	if implementsCustomConversion(typeFrom) {
+		return
+	}
+
+	opts := a.expander.getOptions()
+	// Nested objects to analyze, keyed by model struct type.
+	nested := make(map[reflect.Type][]reflect.Type)
+	var nestedOrder []reflect.Type
+	nestedPaths := make(map[reflect.Type]string)
+
+	for fromField := range tfreflect.ExportedStructFields(typeFrom) {
+		fieldName := fromField.Name
+		if opts.isIgnoredField(fieldName) {
+			continue
+		}
+		nameOverride, tagOpts := autoflexTags(fromField)
+		if nameOverride == "-" {
+			continue
+		}
+		if fieldName == mapBlockKeyFieldName {
+			continue
+		}
+
+		fieldPath := joinFieldPath(modelPath, fieldName)
+		matched := false
+
+		for _, typeTo := range typesTo {
+			toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fieldName, typeFrom, typeTo, a.expander)
+			if !ok {
+				continue
+			}
+			matched = true
+
+			// Fields that aren't flattened are typically set by hand from API output types, so only the expanded type could be checked.
+			// The analyzer doesn't distinguish input from output types, so such fields aren't checked.
+			if !tagOpts.NoFlatten() && !compatibleFieldTypes(fromField.Type, toField.Type) {
+				a.report.TypeMismatches = append(a.report.TypeMismatches, fmt.Sprintf("%s (%s) <-> %s (%s)",
+					fieldPath, fromField.Type, joinFieldPath(typeTo.Name(), toField.Name), toField.Type))
+				continue
+			}
+
+			if nestedFrom, nestedTo := nestedStructTypes(ctx, fromField.Type, toField.Type); nestedFrom != nil && nestedTo != nil {
+				if _, ok := nested[nestedFrom]; !ok {
+					nestedOrder = append(nestedOrder, nestedFrom)
+					nestedPaths[nestedFrom] = fieldPath
+				}
+				if !slices.Contains(nested[nestedFrom], nestedTo) {
+					nested[nestedFrom] = append(nested[nestedFrom], nestedTo)
+				}
+			}
+		}
+
+		if !matched && (modelPath != "" || !slices.Contains(unreportedModelAttributes, tfsdkName(fromField))) {
+			a.report.UnmappedModelFields = append(a.report.UnmappedModelFields, fieldPath)
+		}
+	}
+
+	for _, typeTo := range typesTo {
+		for toField := range tfreflect.ExportedStructFields(typeTo) {
+			fieldName := toField.Name
+			if opts.isIgnoredField(fieldName) || slices.Contains(unreportedAPIFieldNames, fieldName) {
+				continue
+			}
+
+			if _, ok := (&fuzzyFieldFinder{}).findField(ctx, fieldName, typeTo, typeFrom, a.flattener); !ok {
+				a.report.UnmappedAPIFields = append(a.report.UnmappedAPIFields, joinFieldPath(typeTo.Name(), fieldName))
+			}
+		}
+	}
+
+	for _, nestedFrom := range nestedOrder {
+		a.analyzeStruct(ctx, nestedPaths[nestedFrom], nestedFrom, nested[nestedFrom])
+	}
+}
+
+// compatibleFieldTypes returns whether AutoFlex can convert between model field type `typeFrom`
+// and API field type `typeTo`.
+// Model field types that are not Plugin Framework values are not checked.
+func compatibleFieldTypes(typeFrom, typeTo reflect.Type) bool {
+	if implementsCustomConversion(typeFrom) {
+		return true
+	}
+
+	v, ok := reflect.Zero(typeFrom).Interface().(attr.Value)
+	if !ok {
+		return true
+	}
+
+	kind := typeTo.Kind()
+	if kind == reflect.Pointer {
+		kind = typeTo.Elem().Kind()
+	}
+
+	switch v.(type) {
+	case fwtypes.NestedObjectCollectionValue:
+		return slices.Contains([]reflect.Kind{reflect.Struct, reflect.Interface, reflect.Map, reflect.Slice}, kind)
+
+	case fwtypes.NestedObjectValue:
+		return slices.Contains([]reflect.Kind{reflect.Struct, reflect.Interface}, kind)
+
+	case basetypes.BoolValuable:
+		return kind == reflect.Bool
+
+	case basetypes.Float64Valuable:
+		return kind == reflect.Float32 || kind == reflect.Float64
+
+	case basetypes.Float32Valuable:
+		return kind == reflect.Float32
+
+	case basetypes.Int64Valuable:
+		return kind == reflect.Int32 || kind == reflect.Int64
+
+	case basetypes.Int32Valuable:
+		return kind == reflect.Int32
+
+	case basetypes.StringValuable:
+		return slices.Contains([]reflect.Kind{reflect.String, reflect.Slice, reflect.Struct, reflect.Interface}, kind)
+
+	case basetypes.ObjectValuable:
+		return slices.Contains([]reflect.Kind{reflect.Struct, reflect.Interface}, kind)
+
+	case basetypes.ListValuable, basetypes.SetValuable:
+		return kind == reflect.Slice || kind == reflect.Array || kind == reflect.Struct
+
+	case basetypes.MapValuable:
+		return kind == reflect.Map
+	}
+
+	return true
+}
+
+// nestedStructTypes returns the model and API struct types of corresponding nested object fields.
+func nestedStructTypes(ctx context.Context, typeFrom, typeTo reflect.Type) (reflect.Type, reflect.Type) {
+	v, ok := reflect.Zero(typeFrom).Interface().(fwtypes.NestedObjectValue)
+	if !ok {
+		return nil, nil
+	}
+
+	typ, ok := v.Type(ctx).(fwtypes.NestedObjectType)
+	if !ok {
+		return nil, nil
+	}
+
+	ptr, diags := typ.NewObjectPtr(ctx)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	nestedTo := typeTo
+	for nestedTo.Kind() == reflect.Pointer || nestedTo.Kind() == reflect.Slice || nestedTo.Kind() == reflect.Map {
+		nestedTo = nestedTo.Elem()
+	}
+	if potentialXMLWrapperStruct(nestedTo) {
+		if field, ok := nestedTo.FieldByName(getXMLWrapperSliceFieldName(nestedTo)); ok {
+			nestedTo = structType(field.Type.Elem())
+		}
+	}
+
+	return structType(reflect.TypeOf(ptr)), structType(nestedTo)
+}
+
+// implementsCustomConversion returns whether values of the specified type customize their conversion.
+func implementsCustomConversion(typ reflect.Type) bool {
+	ptr := reflect.PointerTo(typ)
+	for _, iface := range []reflect.Type{
+		reflect.TypeFor[Expander](),
+		reflect.TypeFor[TypedExpander](),
+		reflect.TypeFor[Flattener](),
+		reflect.TypeFor[UnionModel](),
+	} {
+		if typ.Implements(iface) || ptr.Implements(iface) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// structType returns the struct type underlying `typ`, or nil.
+func structType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return typ
+}
+
+func tfsdkName(field reflect.StructField) string {
+	name, _ := parseTag(field.Tag.Get("tfsdk"))
+	return name
+}
+
+func joinFieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfAnalyzeModel struct {
+	ID          types.String                                     `tfsdk:"id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Count       types.Int64                                      `tfsdk:"count"`
+	Enabled     types.String                                     `tfsdk:"enabled"`
+	Missing     types.String                                     `tfsdk:"missing"`
+	Nested      fwtypes.ListNestedObjectValueOf[tfAnalyzeNested] `tfsdk:"nested"`
+	Skipped     types.String                                     `tfsdk:"skipped" autoflex:"-"`
+	Timeouts    timeouts.Value                                   `tfsdk:"timeouts"`
+	Description types.String                                     `tfsdk:"description"`
+}
+
+type tfAnalyzeNested struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.Bool   `tfsdk:"value"`
+	Extra types.String `tfsdk:"extra"`
+}
+
+type awsAnalyzeInput struct {
+	Name    *string
+	Count   *int32
+	Enabled *bool
+	Nested  []awsAnalyzeNested
+	Token   *string
+}
+
+type awsAnalyzeNested struct {
+	Key   *string
+	Value *string
+}
+
+type tfAnalyzeNoFlattenModel struct {
+	Name  types.String `tfsdk:"name"`
+	Count types.String `tfsdk:"count" autoflex:",noflatten"`
+}
+
+type awsAnalyzeOutput struct {
+	Description *string
+	Name        *string
+}
+
+func TestAnalyzeFieldMappings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		tfObject   any
+		apiObjects []any
+		options    []AutoFlexOptionsFunc
+		want       FieldMappingReport
+	}{
+		"input and output": {
+			tfObject:   tfAnalyzeModel{},
+			apiObjects: []any{awsAnalyzeInput{}, &awsAnalyzeOutput{}},
+			want: FieldMappingReport{
+				UnmappedModelFields: []string{"Missing", "Nested.Extra"},
+				UnmappedAPIFields:   []string{"awsAnalyzeInput.Token"},
+				TypeMismatches: []string{
+					"Enabled (basetypes.StringValue) <-> awsAnalyzeInput.Enabled (*bool)",
+					"Nested.Value (basetypes.BoolValue) <-> awsAnalyzeNested.Value (*string)",
+				},
+			},
+		},
+		"ignored fields": {
+			tfObject:   &tfAnalyzeModel{},
+			apiObjects: []any{awsAnalyzeInput{}},
+			options:    []AutoFlexOptionsFunc{WithIgnoredFieldNamesAppend("Token"), WithIgnoredFieldNamesAppend("Missing")},
+			want: FieldMappingReport{
+				UnmappedModelFields: []string{"Description", "Nested.Extra"},
+				TypeMismatches: []string{
+					"Enabled (basetypes.StringValue) <-> awsAnalyzeInput.Enabled (*bool)",
+					"Nested.Value (basetypes.BoolValue) <-> awsAnalyzeNested.Value (*string)",
+				},
+			},
+		},
+		"noflatten": {
+			tfObject:   tfAnalyzeNoFlattenModel{},
+			apiObjects: []any{awsAnalyzeInput{}},
+			want: FieldMappingReport{
+				UnmappedAPIFields: []string{"awsAnalyzeInput.Enabled", "awsAnalyzeInput.Nested", "awsAnalyzeInput.Token"},
+			},
+		},
+		"no API objects": {
+			tfObject: tfAnalyzeNested{},
+			want: FieldMappingReport{
+				UnmappedModelFields: []string{"Extra", "Key", "Value"},
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			got := AnalyzeFieldMappings(ctx, testCase.tfObject, testCase.apiObjects, testCase.options...)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ package flex
 import (
 	"context"
 	"fmt"
-	"iter"
 	"reflect"
 	"strings"
 	"sync"
@@ -141,6 +140,13 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 			diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
 			return diags
 		}
+
+		// Top-level union model to union conversion.
+		if _, ok := from.(UnionModel); ok && valTo.Kind() == reflect.Interface {
+			tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+			diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+			return diags
+		}
 	}
 
 	// Anything else.
@@ -1391,6 +1397,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := from.(UnionModel); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionModel")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, fromUnion, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1409,9 +1421,10 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
-	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
+	for fieldPlan := range cachedStructPlan(ctx, planDirectionExpand, typeFrom, typeTo, flexer).sourceFields(ctx) {
+		fromField := fieldPlan.fromField
 		fromFieldName := fromField.Name
-		_, fromFieldOpts := autoflexTags(fromField)
+		fromFieldOpts := fieldPlan.fromOpts
 
 		// TRACE: Log XML wrapper tag detection
 		if xmlWrapperField := fromFieldOpts.XMLWrapperField(); xmlWrapperField != "" {
@@ -1429,14 +1442,14 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			continue
 		}
 
-		toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromFieldName, typeFrom, typeTo, flexer)
-		if !ok {
+		if !fieldPlan.matched {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
 			continue
 		}
+		toField := fieldPlan.toField
 		toFieldName := toField.Name
 		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
@@ -1469,39 +1482,6 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
-func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
-	return func(yield func(reflect.StructField) bool) {
-		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			fromNameOverride, _ := autoflexTags(field)
-			if fromNameOverride == "-" {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			if fieldName == mapBlockKeyFieldName {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
-					logAttrKeySourceFieldname: mapBlockKeyFieldName,
-				})
-				continue
-			}
-
-			if !yield(field) {
-				return
-			}
-		}
-	}
-}
-
 // mapBlockKey takes a struct and extracts the value of the `key`
 func mapBlockKey(ctx context.Context, from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
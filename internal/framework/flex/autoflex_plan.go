@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// A struct conversion plan records, for each exported field of a source struct type, whether the field
+// is skipped and, if not, the target struct field it maps to.
+// Field matching (including fuzzy matching) depends only on the source and target types and the
+// AutoFlex options, so plans are built once and cached globally for the life of the process.
+
+type planDirection int
+
+const (
+	planDirectionExpand planDirection = iota
+	planDirectionFlatten
+)
+
+// structPlanKey identifies a cached struct conversion plan.
+type structPlanKey struct {
+	direction planDirection
+	typeFrom  reflect.Type
+	typeTo    reflect.Type
+	options   string
+}
+
+// structFieldPlan is the precomputed conversion of a single source struct field.
+type structFieldPlan struct {
+	fromField reflect.StructField
+	fromOpts  tagOptions
+	// skipReason is logged when the source field is skipped. Empty if the field is not skipped.
+	skipReason string
+
+	toField        reflect.StructField
+	toNameOverride string
+	toOpts         tagOptions
+	// matched is true if a corresponding target field was found.
+	matched bool
+}
+
+// structPlan is the precomputed conversion of a source struct type to a target struct type.
+type structPlan struct {
+	fields []structFieldPlan
+}
+
+var (
+	// structPlanCache caches struct conversion plans, keyed by structPlanKey.
+	structPlanCache sync.Map
+)
+
+// cachedStructPlan returns the conversion plan for the specified source and target struct types,
+// building and caching it on first use.
+func cachedStructPlan(ctx context.Context, direction planDirection, typeFrom, typeTo reflect.Type, flexer autoFlexer) *structPlan {
+	opts := flexer.getOptions()
+	key := structPlanKey{
+		direction: direction,
+		typeFrom:  typeFrom,
+		typeTo:    typeTo,
+		options:   opts.cacheKey(),
+	}
+
+	if v, ok := structPlanCache.Load(key); ok {
+		return v.(*structPlan)
+	}
+
+	v, _ := structPlanCache.LoadOrStore(key, newStructPlan(ctx, direction, typeFrom, typeTo, opts, flexer))
+
+	return v.(*structPlan)
+}
+
+func newStructPlan(ctx context.Context, direction planDirection, typeFrom, typeTo reflect.Type, opts AutoFlexOptions, flexer autoFlexer) *structPlan {
+	plan := &structPlan{}
+
+	for fromField := range tfreflect.ExportedStructFields(typeFrom) {
+		fromFieldName := fromField.Name
+		fromNameOverride, fromOpts := autoflexTags(fromField)
+		fieldPlan := structFieldPlan{
+			fromField: fromField,
+			fromOpts:  fromOpts,
+		}
+
+		switch {
+		case opts.isIgnoredField(fromFieldName):
+			fieldPlan.skipReason = "Skipping ignored source field"
+		case direction == planDirectionExpand && fromNameOverride == "-":
+			fieldPlan.skipReason = "Skipping ignored source field"
+		case direction == planDirectionExpand && fromFieldName == mapBlockKeyFieldName:
+			fieldPlan.skipReason = "Skipping map block key"
+		default:
+			if toField, ok := (&fuzzyFieldFinder{}).findField(ctx, fromFieldName, typeFrom, typeTo, flexer); ok {
+				fieldPlan.toField = toField
+				fieldPlan.toNameOverride, fieldPlan.toOpts = autoflexTags(toField)
+				fieldPlan.matched = true
+			}
+		}
+
+		plan.fields = append(plan.fields, fieldPlan)
+	}
+
+	return plan
+}
+
+// sourceFields returns the plan's source fields that are not skipped.
+// Skipped fields are logged.
+func (p *structPlan) sourceFields(ctx context.Context) iter.Seq[*structFieldPlan] {
+	return func(yield func(*structFieldPlan) bool) {
+		for i := range p.fields {
+			fieldPlan := &p.fields[i]
+			if fieldPlan.skipReason != "" {
+				tflog.SubsystemTrace(ctx, subsystemName, fieldPlan.skipReason, map[string]any{
+					logAttrKeySourceFieldname: fieldPlan.fromField.Name,
+				})
+				continue
+			}
+
+			if !yield(fieldPlan) {
+				return
+			}
+		}
+	}
+}
+
+// cacheKey returns a string that uniquely identifies the options' effect on field matching.
+func (o *AutoFlexOptions) cacheKey() string {
+	var sb strings.Builder
+
+	sb.WriteString(o.fieldNamePrefix)
+	sb.WriteByte(0)
+	sb.WriteString(o.fieldNameSuffix)
+	for _, v := range o.ignoredFieldNames {
+		sb.WriteByte(0)
+		sb.WriteString(v)
+	}
+
+	return sb.String()
+}
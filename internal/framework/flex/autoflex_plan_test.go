@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests and benchmarks for AutoFlex's cached struct conversion plans.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfPlanContainer struct {
+	Name        types.String                                     `tfsdk:"name"`
+	Description types.String                                     `tfsdk:"description"`
+	Image       types.String                                     `tfsdk:"image"`
+	Cpu         types.Int64                                      `tfsdk:"cpu"`
+	Memory      types.Int64                                      `tfsdk:"memory"`
+	Essential   types.Bool                                       `tfsdk:"essential"`
+	Command     fwtypes.ListValueOf[types.String]                `tfsdk:"command"`
+	EntryPoint  fwtypes.ListValueOf[types.String]                `tfsdk:"entry_point"`
+	Environment fwtypes.ListNestedObjectValueOf[tfPlanKeyValue]  `tfsdk:"environment"`
+	PortMapping fwtypes.ListNestedObjectValueOf[tfPlanPortEntry] `tfsdk:"port_mapping"`
+	Labels      fwtypes.MapValueOf[types.String]                 `tfsdk:"labels"`
+}
+
+type tfPlanKeyValue struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type tfPlanPortEntry struct {
+	ContainerPort types.Int64  `tfsdk:"container_port"`
+	HostPort      types.Int64  `tfsdk:"host_port"`
+	Protocol      types.String `tfsdk:"protocol"`
+}
+
+type tfPlanTaskDefinition struct {
+	Family      types.String                                     `tfsdk:"family"`
+	TaskRoleARN types.String                                     `tfsdk:"task_role_arn"`
+	NetworkMode types.String                                     `tfsdk:"network_mode"`
+	Containers  fwtypes.ListNestedObjectValueOf[tfPlanContainer] `tfsdk:"containers"`
+	Tags        fwtypes.MapValueOf[types.String]                 `tfsdk:"tags"`
+}
+
+type awsPlanContainer struct {
+	Name         *string
+	Description  *string
+	Image        *string
+	Cpu          int32
+	Memory       *int32
+	Essential    *bool
+	Command      []string
+	EntryPoint   []string
+	Environment  []awsPlanKeyValue
+	PortMappings []awsPlanPortEntry
+	Labels       map[string]string
+}
+
+type awsPlanKeyValue struct {
+	Name  *string
+	Value *string
+}
+
+type awsPlanPortEntry struct {
+	ContainerPort *int32
+	HostPort      *int32
+	Protocol      string
+}
+
+type awsPlanTaskDefinition struct {
+	Family      *string
+	TaskRoleArn *string
+	NetworkMode string
+	Containers  []awsPlanContainer
+}
+
+func newPlanBenchmarkSource(n int) *awsPlanTaskDefinition {
+	td := &awsPlanTaskDefinition{
+		Family:      aws.String("family"),
+		TaskRoleArn: aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		NetworkMode: "awsvpc",
+	}
+
+	for range n {
+		td.Containers = append(td.Containers, awsPlanContainer{
+			Name:        aws.String("name"),
+			Description: aws.String("description"),
+			Image:       aws.String("image"),
+			Cpu:         256,
+			Memory:      aws.Int32(512),
+			Essential:   aws.Bool(true),
+			Command:     []string{"sh", "-c", "true"},
+			EntryPoint:  []string{"/bin/init"},
+			Environment: []awsPlanKeyValue{
+				{Name: aws.String("K1"), Value: aws.String("V1")},
+				{Name: aws.String("K2"), Value: aws.String("V2")},
+			},
+			PortMappings: []awsPlanPortEntry{
+				{ContainerPort: aws.Int32(80), HostPort: aws.Int32(80), Protocol: "tcp"},
+			},
+			Labels: map[string]string{"k1": "v1", "k2": "v2"},
+		})
+	}
+
+	return td
+}
+
+func TestCachedStructPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typeFrom, typeTo := reflect.TypeFor[tfPlanContainer](), reflect.TypeFor[awsPlanContainer]()
+
+	plan := cachedStructPlan(ctx, planDirectionExpand, typeFrom, typeTo, newAutoExpander(nil))
+	if got := cachedStructPlan(ctx, planDirectionExpand, typeFrom, typeTo, newAutoExpander(nil)); got != plan {
+		t.Error("expected cached plan for the same types and options")
+	}
+	if got := cachedStructPlan(ctx, planDirectionExpand, typeFrom, typeTo, newAutoExpander([]AutoFlexOptionsFunc{WithIgnoredFieldNamesAppend("Image")})); got == plan {
+		t.Error("expected a different plan for different options")
+	}
+	if got := cachedStructPlan(ctx, planDirectionFlatten, typeFrom, typeTo, newAutoFlattener(nil)); got == plan {
+		t.Error("expected a different plan for a different direction")
+	}
+
+	var matched []string
+	for fieldPlan := range plan.sourceFields(ctx) {
+		if fieldPlan.matched {
+			matched = append(matched, fieldPlan.fromField.Name+"->"+fieldPlan.toField.Name)
+		}
+	}
+	if got, want := len(matched), 11; got != want {
+		t.Fatalf("matched %d fields (%v), want %d", got, matched, want)
+	}
+	if got, want := matched[9], "PortMapping->PortMappings"; got != want {
+		t.Errorf("matched[9] = %q, want %q", got, want)
+	}
+}
+
+// The following benchmarks convert a model with 50 nested objects, reusing cached plans.
+
+func BenchmarkFlattenLargeModel(b *testing.B) {
+	ctx := context.Background()
+	source := newPlanBenchmarkSource(50)
+
+	for b.Loop() {
+		var target tfPlanTaskDefinition
+		if diags := Flatten(ctx, source, &target); diags.HasError() {
+			b.Fatalf("Flatten: %v", diags)
+		}
+	}
+}
+
+func BenchmarkExpandLargeModel(b *testing.B) {
+	ctx := context.Background()
+
+	var source tfPlanTaskDefinition
+	if diags := Flatten(ctx, newPlanBenchmarkSource(50), &source); diags.HasError() {
+		b.Fatalf("Flatten: %v", diags)
+	}
+
+	for b.Loop() {
+		var target awsPlanTaskDefinition
+		if diags := Expand(ctx, source, &target); diags.HasError() {
+			b.Fatalf("Expand: %v", diags)
+		}
+	}
+}
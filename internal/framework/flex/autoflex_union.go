@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+const (
+	unionMemberNameInfix  = "Member"
+	unionMemberValueField = "Value"
+)
+
+// UnionModel is implemented by resource model types that represent an AWS SDK for Go v2 union type.
+// A union type is an interface with one `<Union>Member<Name>` struct per variant, each holding the
+// variant's data in a `Value` field, e.g. `types.DestinationMemberS3Bucket`.
+//
+// The model has one optional field per variant, named `<Name>`, typically a
+// `fwtypes.ListNestedObjectValueOf[...]` for structure variants or a primitive for primitive variants.
+// On expand the single non-null field is converted to the corresponding member type.
+// On flatten the field corresponding to the source member type is set and all other fields are null.
+//
+// UnionMembers should be implemented on the model's value type so that both values and pointers implement UnionModel.
+type UnionModel interface {
+	// UnionMembers returns a pointer to a zero value of each union member type,
+	// e.g. []any{&types.DestinationMemberFirehoseStream{}, &types.DestinationMemberS3Bucket{}}.
+	UnionMembers() []any
+}
+
+// expandUnion expands a UnionModel struct to the AWS API union (interface) value `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, fromUnion UnionModel, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+
+	var setFields []reflect.StructField
+	for field := range tfreflect.ExportedStructFields(typeFrom) {
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		setFields = append(setFields, field)
+	}
+
+	switch n := len(setFields); n {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding union with no member set")
+		return diags
+
+	case 1:
+
+	default:
+		names := make([]string, n)
+		for i, field := range setFields {
+			names[i] = unionFieldName(field)
+		}
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union with multiple members set", map[string]any{
+			"members": names,
+		})
+		diags.Append(diagExpandingMultipleUnionMembers(sourcePath, typeFrom, names))
+		return diags
+	}
+
+	fromField := setFields[0]
+	tMember, ok := unionMemberTypeForField(fromUnion, fromField.Name, valTo.Type())
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No union member type for field", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+		})
+		diags.Append(diagExpandingNoUnionMember(typeFrom, fromField.Name, valTo.Type()))
+		return diags
+	}
+
+	member := reflect.New(tMember)
+	toFieldVal := member.Elem().FieldByName(unionMemberValueField)
+	if !toFieldVal.IsValid() {
+		diags.Append(diagExpandingNoUnionMember(typeFrom, fromField.Name, valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Expanding union member", map[string]any{
+		logAttrKeySourceFieldname: fromField.Name,
+		logAttrKeyTargetType:      fullTypeName(tMember),
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), valFrom.FieldByIndex(fromField.Index), targetPath.AtName(unionMemberValueField), toFieldVal, fieldOpts{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	valTo.Set(member)
+
+	return diags
+}
+
+// flattenUnion flattens the AWS API union member struct `valFrom` to the UnionModel struct `valTo`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, toUnion UnionModel, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	if !isUnionMember(toUnion, typeFrom) {
+		// e.g. types.UnknownUnionMember.
+		tflog.SubsystemWarn(ctx, subsystemName, "Source is not a known union member", map[string]any{
+			logAttrKeySourceType: fullTypeName(typeFrom),
+		})
+		return diags
+	}
+
+	toField, ok := unionFieldForMemberType(valTo.Type(), typeFrom)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No union model field for member", map[string]any{
+			logAttrKeySourceType: fullTypeName(typeFrom),
+		})
+		diags.Append(DiagFlatteningIncompatibleTypes(typeFrom, valTo.Type()))
+		return diags
+	}
+	fieldName := toField.Name
+
+	fromFieldVal := valFrom.FieldByName(unionMemberValueField)
+	if !fromFieldVal.IsValid() {
+		diags.Append(DiagFlatteningIncompatibleTypes(typeFrom, valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Flattening union member", map[string]any{
+		logAttrKeySourceType:      fullTypeName(typeFrom),
+		logAttrKeyTargetFieldname: fieldName,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueField), fromFieldVal, targetPath.AtName(fieldName), valTo.FieldByIndex(toField.Index), fieldOpts{})...)
+
+	return diags
+}
+
+// unionMemberTypeForField returns the (non-pointer) member type corresponding to the specified model field.
+// The member type must implement the union interface type `tUnion`.
+func unionMemberTypeForField(union UnionModel, fieldName string, tUnion reflect.Type) (reflect.Type, bool) {
+	for _, v := range union.UnionMembers() {
+		t := reflect.TypeOf(v)
+		if t == nil {
+			continue
+		}
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if !isUnionMemberForField(t, fieldName) {
+			continue
+		}
+
+		if !reflect.PointerTo(t).Implements(tUnion) {
+			continue
+		}
+
+		return t, true
+	}
+
+	return nil, false
+}
+
+// isUnionMember returns whether the specified (non-pointer) type is one of the union's member types.
+func isUnionMember(union UnionModel, t reflect.Type) bool {
+	for _, v := range union.UnionMembers() {
+		tMember := reflect.TypeOf(v)
+		if tMember == nil {
+			continue
+		}
+		if tMember.Kind() == reflect.Pointer {
+			tMember = tMember.Elem()
+		}
+
+		if tMember == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unionFieldForMemberType returns the model field corresponding to the specified (non-pointer) member type.
+func unionFieldForMemberType(tModel, tMember reflect.Type) (reflect.StructField, bool) {
+	for field := range tfreflect.ExportedStructFields(tModel) {
+		if isUnionMemberForField(tMember, field.Name) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// isUnionMemberForField returns whether the specified member type corresponds to the specified model field,
+// e.g. "DestinationMemberS3Bucket" corresponds to "S3Bucket".
+func isUnionMemberForField(tMember reflect.Type, fieldName string) bool {
+	return strings.HasSuffix(tMember.Name(), unionMemberNameInfix+fieldName)
+}
+
+func diagExpandingMultipleUnionMembers(sourcePath path.Path, sourceType reflect.Type, fieldNames []string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		sourcePath,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Only one of %s can be specified, got %s.", strings.Join(unionFieldNames(sourceType), ", "), strings.Join(fieldNames, ", ")),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of %q does not correspond to a member of union type %q.", fieldName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
+func unionFieldNames(t reflect.Type) []string {
+	var names []string
+
+	for field := range tfreflect.ExportedStructFields(t) {
+		names = append(names, unionFieldName(field))
+	}
+
+	return names
+}
+
+// unionFieldName returns the Terraform attribute name of a union model field.
+func unionFieldName(field reflect.StructField) string {
+	if v := field.Tag.Get("tfsdk"); v != "" {
+		return fmt.Sprintf("%q", v)
+	}
+
+	return field.Name
+}
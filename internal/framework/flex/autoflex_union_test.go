@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's Expand/Flatten of AWS SDK for Go v2 union (tagged-union interface) types.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type awsUnionDestination interface {
+	isAWSUnionDestination()
+}
+
+type awsUnionDestinationMemberS3Bucket struct {
+	Value awsUnionS3Bucket
+}
+
+func (*awsUnionDestinationMemberS3Bucket) isAWSUnionDestination() {}
+
+type awsUnionDestinationMemberStreamName struct {
+	Value string
+}
+
+func (*awsUnionDestinationMemberStreamName) isAWSUnionDestination() {}
+
+type awsUnionDestinationMemberUnknown struct {
+	Value string
+}
+
+func (*awsUnionDestinationMemberUnknown) isAWSUnionDestination() {}
+
+type awsUnionS3Bucket struct {
+	BucketName *string
+	Prefix     *string
+}
+
+type awsUnionContainer struct {
+	Destination  awsUnionDestination
+	Destinations []awsUnionDestination
+}
+
+type tfUnionDestination struct {
+	S3Bucket   fwtypes.ListNestedObjectValueOf[tfUnionS3Bucket] `tfsdk:"s3_bucket"`
+	StreamName types.String                                     `tfsdk:"stream_name"`
+}
+
+var _ UnionModel = tfUnionDestination{}
+
+func (tfUnionDestination) UnionMembers() []any {
+	return []any{
+		&awsUnionDestinationMemberS3Bucket{},
+		&awsUnionDestinationMemberStreamName{},
+	}
+}
+
+type tfUnionS3Bucket struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Prefix     types.String `tfsdk:"prefix"`
+}
+
+type tfUnionContainer struct {
+	Destination  fwtypes.ListNestedObjectValueOf[tfUnionDestination] `tfsdk:"destination"`
+	Destinations fwtypes.ListNestedObjectValueOf[tfUnionDestination] `tfsdk:"destinations"`
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"structure member": {
+			Source: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringValue("prefix/"),
+					}),
+					StreamName: types.StringNull(),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			Target: &awsUnionContainer{},
+			WantTarget: &awsUnionContainer{
+				Destination: &awsUnionDestinationMemberS3Bucket{
+					Value: awsUnionS3Bucket{
+						BucketName: aws.String("bucket"),
+						Prefix:     aws.String("prefix/"),
+					},
+				},
+			},
+		},
+		"primitive member": {
+			Source: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					StreamName: types.StringValue("stream"),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			Target: &awsUnionContainer{},
+			WantTarget: &awsUnionContainer{
+				Destination: &awsUnionDestinationMemberStreamName{
+					Value: "stream",
+				},
+			},
+		},
+		"no member": {
+			Source: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					StreamName: types.StringNull(),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			Target:     &awsUnionContainer{},
+			WantTarget: &awsUnionContainer{},
+		},
+		"multiple members": {
+			Source: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringNull(),
+					}),
+					StreamName: types.StringValue("stream"),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+			Target: &awsUnionContainer{},
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(path.Root("Destination").AtListIndex(0), reflect.TypeFor[tfUnionDestination](), []string{`"s3_bucket"`, `"stream_name"`}),
+			},
+		},
+		"slice of unions": {
+			Source: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionDestination{
+					{
+						S3Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+							BucketName: types.StringValue("bucket"),
+							Prefix:     types.StringNull(),
+						}),
+						StreamName: types.StringNull(),
+					},
+					{
+						S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+						StreamName: types.StringValue("stream"),
+					},
+				}),
+			},
+			Target: &awsUnionContainer{},
+			WantTarget: &awsUnionContainer{
+				Destinations: []awsUnionDestination{
+					&awsUnionDestinationMemberS3Bucket{
+						Value: awsUnionS3Bucket{
+							BucketName: aws.String("bucket"),
+						},
+					},
+					&awsUnionDestinationMemberStreamName{
+						Value: "stream",
+					},
+				},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestExpandUnionTopLevel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	source := tfUnionDestination{
+		S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+		StreamName: types.StringValue("stream"),
+	}
+
+	var target awsUnionDestination
+	if diags := Expand(ctx, source, &target); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := target, awsUnionDestination(&awsUnionDestinationMemberStreamName{Value: "stream"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"structure member": {
+			Source: &awsUnionContainer{
+				Destination: &awsUnionDestinationMemberS3Bucket{
+					Value: awsUnionS3Bucket{
+						BucketName: aws.String("bucket"),
+						Prefix:     aws.String("prefix/"),
+					},
+				},
+			},
+			Target: &tfUnionContainer{},
+			WantTarget: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+						BucketName: types.StringValue("bucket"),
+						Prefix:     types.StringValue("prefix/"),
+					}),
+					StreamName: types.StringNull(),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"primitive member": {
+			Source: &awsUnionContainer{
+				Destination: &awsUnionDestinationMemberStreamName{
+					Value: "stream",
+				},
+			},
+			Target: &tfUnionContainer{},
+			WantTarget: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					StreamName: types.StringValue("stream"),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"nil": {
+			Source: &awsUnionContainer{},
+			Target: &tfUnionContainer{},
+			WantTarget: &tfUnionContainer{
+				Destination:  fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"unknown member": {
+			Source: &awsUnionContainer{
+				Destination: &awsUnionDestinationMemberUnknown{
+					Value: "unknown",
+				},
+			},
+			Target: &tfUnionContainer{},
+			WantTarget: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionDestination{
+					S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+					StreamName: types.StringNull(),
+				}),
+				Destinations: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+			},
+		},
+		"slice of unions": {
+			Source: &awsUnionContainer{
+				Destinations: []awsUnionDestination{
+					&awsUnionDestinationMemberS3Bucket{
+						Value: awsUnionS3Bucket{
+							BucketName: aws.String("bucket"),
+						},
+					},
+					&awsUnionDestinationMemberStreamName{
+						Value: "stream",
+					},
+				},
+			},
+			Target: &tfUnionContainer{},
+			WantTarget: &tfUnionContainer{
+				Destination: fwtypes.NewListNestedObjectValueOfNull[tfUnionDestination](ctx),
+				Destinations: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionDestination{
+					{
+						S3Bucket: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionS3Bucket{
+							BucketName: types.StringValue("bucket"),
+							Prefix:     types.StringNull(),
+						}),
+						StreamName: types.StringNull(),
+					},
+					{
+						S3Bucket:   fwtypes.NewListNestedObjectValueOfNull[tfUnionS3Bucket](ctx),
+						StreamName: types.StringValue("stream"),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/privatestate"
+)
+
+// WriteOnlyVersion returns a plan modifier for a computed version attribute that plans an update
+// if the value of the write-only string attribute at the specified path changes.
+// Changes are detected by comparing the configured value with the hash stored in private state under the specified key,
+// so no companion *_wo_version argument is needed.
+// The resource's Create and Update handlers must store the value in a privatestate.WriteOnlyValueStore and increment the version when it changes.
+func WriteOnlyVersion(writeOnlyAttributePath path.Path, privateStateKey string) planmodifier.Int64 {
+	return writeOnlyVersionModifier{
+		path:            writeOnlyAttributePath,
+		privateStateKey: privateStateKey,
+	}
+}
+
+type writeOnlyVersionModifier struct {
+	path            path.Path
+	privateStateKey string
+}
+
+func (m writeOnlyVersionModifier) Description(_ context.Context) string {
+	return "If the value of the write-only attribute changes, the version will be incremented."
+}
+
+func (m writeOnlyVersionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m writeOnlyVersionModifier) PlanModifyInt64(ctx context.Context, request planmodifier.Int64Request, response *planmodifier.Int64Response) {
+	// The version is unknown on creation.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var value types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, m.path, &value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if value.IsUnknown() {
+		response.PlanValue = types.Int64Unknown()
+		return
+	}
+
+	woStore := privatestate.NewWriteOnlyValueStore(request.Private, m.privateStateKey)
+	hasValue, diags := woStore.HasValue(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	changed := value.IsNull() == hasValue
+	if !changed && hasValue {
+		equal, diags := woStore.EqualValue(ctx, value)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		changed = !equal
+	}
+
+	if changed {
+		response.PlanValue = types.Int64Unknown()
+		return
+	}
+
+	// Other changes to the resource don't change the version.
+	if request.PlanValue.IsUnknown() {
+		response.PlanValue = request.StateValue
+	}
+}
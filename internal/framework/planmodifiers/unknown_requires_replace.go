@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// WarnOnUnknownRequiresReplace adds a warning diagnostic to the response for each attribute that requires resource replacement
+// and whose configured value is unknown at plan time because it depends on a change to another resource or data source.
+// Such replacements are planned even if the value turns out to be unchanged after apply.
+// It must be called after all attribute plan modifiers have run, e.g. from a resource's ModifyPlan method.
+func WarnOnUnknownRequiresReplace(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Only updates can cause replacement.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, p := range response.RequiresReplace {
+		if seen[p.String()] {
+			continue
+		}
+		seen[p.String()] = true
+
+		// Ignore any errors, e.g. for paths that can't be addressed in configuration.
+		var config attr.Value
+		if diags := request.Config.GetAttribute(ctx, p, &config); diags.HasError() || config == nil || !config.IsUnknown() {
+			continue
+		}
+
+		var state attr.Value
+		if diags := request.State.GetAttribute(ctx, p, &state); diags.HasError() || state == nil || state.IsNull() || state.IsUnknown() {
+			continue
+		}
+
+		response.Diagnostics.AddAttributeWarning(p,
+			"Resource replacement depends on an unknown value",
+			fmt.Sprintf("The value of %q is not known until apply because it depends on a change to another resource or data source. "+
+				"As changing %[1]q requires replacement, the resource will be replaced, even if the value is unchanged after apply.", p),
+		)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestWarnOnUnknownRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"subnet_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(context.Background())
+	testValue := func(name, subnetID any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrName: tftypes.NewValue(tftypes.String, name),
+			"subnet_id":    tftypes.NewValue(tftypes.String, subnetID),
+		})
+	}
+
+	testCases := map[string]struct {
+		state           tftypes.Value
+		config          tftypes.Value
+		requiresReplace path.Paths
+		expectWarnings  int
+	}{
+		"unknown upstream value": {
+			state:           testValue("test", "subnet-1"),
+			config:          testValue("test", tftypes.UnknownValue),
+			requiresReplace: path.Paths{path.Root("subnet_id")},
+			expectWarnings:  1,
+		},
+		"duplicate paths": {
+			state:           testValue("test", "subnet-1"),
+			config:          testValue("test", tftypes.UnknownValue),
+			requiresReplace: path.Paths{path.Root("subnet_id"), path.Root("subnet_id")},
+			expectWarnings:  1,
+		},
+		"known value": {
+			state:           testValue("test", "subnet-1"),
+			config:          testValue("test", "subnet-2"),
+			requiresReplace: path.Paths{path.Root("subnet_id")},
+		},
+		"unknown value not requiring replacement": {
+			state:  testValue("test", "subnet-1"),
+			config: testValue(tftypes.UnknownValue, "subnet-1"),
+		},
+		"create": {
+			state:           tftypes.NewValue(objectType, nil),
+			config:          testValue("test", tftypes.UnknownValue),
+			requiresReplace: path.Paths{path.Root("subnet_id")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: testCase.config},
+				Plan:   tfsdk.Plan{Schema: testSchema, Raw: testCase.config},
+				State:  tfsdk.State{Schema: testSchema, Raw: testCase.state},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan:            request.Plan,
+				RequiresReplace: testCase.requiresReplace,
+			}
+
+			fwplanmodifiers.WarnOnUnknownRequiresReplace(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+			if got, want := response.Diagnostics.WarningsCount(), testCase.expectWarnings; got != want {
+				t.Errorf("expected %d warnings, got %d: %s", want, got, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,15 +5,20 @@ package privatestate
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
+// NewWriteOnlyValueStore returns a store for a keyed hash of a write-only attribute value in private state.
+// Only the hash is stored, so the value itself is never persisted.
 func NewWriteOnlyValueStore(private PrivateState, key string) *WriteOnlyValueStore {
 	return &WriteOnlyValueStore{
 		key:     key,
@@ -38,7 +43,7 @@ func (w *WriteOnlyValueStore) EqualValue(ctx context.Context, value types.String
 		return false, diags
 	}
 
-	return s == sha256Hash(value.ValueString()), diags
+	return equalWriteOnlyValueHash(s, value.ValueString()), diags
 }
 
 func (w *WriteOnlyValueStore) HasValue(ctx context.Context) (bool, diag.Diagnostics) {
@@ -51,11 +56,36 @@ func (w *WriteOnlyValueStore) SetValue(ctx context.Context, val types.String) di
 		return w.private.SetKey(ctx, w.key, []byte(""))
 	}
 
-	return w.private.SetKey(ctx, w.key, []byte(strconv.Quote(sha256Hash(val.ValueString()))))
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("generating write-only value hash key", err.Error())
+		return diags
+	}
+
+	return w.private.SetKey(ctx, w.key, []byte(strconv.Quote(hex.EncodeToString(key)+":"+hmacSHA256(key, val.ValueString()))))
+}
+
+// equalWriteOnlyValueHash returns whether the stored hash is the hash of the specified value.
+// Hashes are stored as "<HMAC key>:<HMAC-SHA256>", hex encoded, with a random key per value.
+// Unkeyed SHA-256 hashes stored by earlier versions of the provider are also supported.
+func equalWriteOnlyValueHash(stored, value string) bool {
+	k, hash, ok := strings.Cut(stored, ":")
+	if !ok {
+		v := sha256.Sum256([]byte(value))
+		return hmac.Equal([]byte(stored), []byte(hex.EncodeToString(v[:])))
+	}
+
+	key, err := hex.DecodeString(k)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(hash), []byte(hmacSHA256(key, value)))
 }
 
-func sha256Hash(data string) string {
-	hash := sha256.New()
-	hash.Write([]byte(data))
-	return hex.EncodeToString(hash.Sum(nil))
+func hmacSHA256(key []byte, data string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
 }
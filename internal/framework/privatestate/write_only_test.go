@@ -5,6 +5,7 @@ package privatestate_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -90,6 +91,34 @@ func TestWriteOnlyValueStore_EqualValue(t *testing.T) {
 	}
 }
 
+func TestWriteOnlyValueStore_keyedHash(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	private1, private2 := &privateState{}, &privateState{}
+	privatestate.NewWriteOnlyValueStore(private1, "key").SetValue(ctx, types.StringValue("value1"))
+	privatestate.NewWriteOnlyValueStore(private2, "key").SetValue(ctx, types.StringValue("value1"))
+
+	// SHA-256 of "value1".
+	const unkeyedHash = "3c9683017f9e4bf33d0fbedd26bf143fd72de9b9dd145441b75f0604047ea28e"
+
+	if got1, got2 := string(private1.data["key"]), string(private2.data["key"]); got1 == got2 {
+		t.Errorf("got equal stored values %s for equal values", got1)
+	}
+	if got := string(private1.data["key"]); strings.Contains(got, unkeyedHash) {
+		t.Errorf("got unkeyed hash in stored value %s", got)
+	}
+
+	legacy := privatestate.NewWriteOnlyValueStore(&privateState{data: map[string][]byte{"key": []byte(`"` + unkeyedHash + `"`)}}, "key")
+	gotEqual, diags := legacy.EqualValue(ctx, types.StringValue("value1"))
+	if diags.HasError() {
+		t.Fatal("unexpected error")
+	}
+	if !gotEqual {
+		t.Error("got not equal for unkeyed hash, want equal")
+	}
+}
+
 type privateState struct {
 	data map[string][]byte
 }
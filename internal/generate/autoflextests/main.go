@@ -0,0 +1,579 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	flexPackagePath      = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	frameworkPackagePath = "github.com/hashicorp/terraform-provider-aws/internal/framework"
+	sdkServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating AutoFlex field mapping tests for internal/service/%s", servicePackage)
+
+	v := &visitor{
+		funcDecls:   make(map[string]*ast.FuncDecl),
+		sdkPackages: make(map[string]*types.Package),
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	// Group resources by source file.
+	byFile := make(map[string][]*resourceModel)
+	for _, r := range v.resources {
+		if len(r.Checks) == 0 {
+			continue
+		}
+		byFile[r.fileName] = append(byFile[r.fileName], r)
+	}
+
+	for fileName, resources := range byFile {
+		slices.SortFunc(resources, func(a, b *resourceModel) int {
+			return strings.Compare(a.ModelName, b.ModelName)
+		})
+
+		imports := make(map[string]string)
+		for _, r := range resources {
+			for _, check := range r.Checks {
+				for path, name := range check.imports {
+					imports[path] = name
+				}
+			}
+		}
+
+		data := fileDatum{
+			PackageName: v.packageName,
+			Resources:   resources,
+		}
+		for path, name := range imports {
+			if name == importName(path) {
+				name = ""
+			}
+			data.GoImports = append(data.GoImports, goImport{
+				Path:  path,
+				Alias: name,
+			})
+		}
+		slices.SortFunc(data.GoImports, func(a, b goImport) int {
+			return strings.Compare(a.Path, b.Path)
+		})
+
+		sourceName := strings.TrimSuffix(filepath.Base(fileName), ".go")
+		filename := fmt.Sprintf("%s_autoflex_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+
+		if err := d.BufferTemplate("autoflextests", resourceTestGoTmpl, data); err != nil {
+			g.Fatalf("generating %q AutoFlex tests: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+	}
+}
+
+type fileDatum struct {
+	PackageName string
+	GoImports   []goImport
+	Resources   []*resourceModel
+}
+
+type goImport struct {
+	Path  string
+	Alias string
+}
+
+// resourceModel is a Plugin Framework resource's model and the AutoFlex conversions that use it.
+type resourceModel struct {
+	fileName  string
+	ModelName string
+	Checks    []*fieldMappingCheck
+}
+
+func (r *resourceModel) TestName() string {
+	runes := []rune(r.ModelName)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return "Test" + string(runes) + "AutoFlex"
+}
+
+func (r *resourceModel) addCheck(options []string, apiObject string, imports map[string]string) {
+	for _, check := range r.Checks {
+		if slices.Equal(check.Options, options) {
+			if !slices.Contains(check.APIObjects, apiObject) {
+				check.APIObjects = append(check.APIObjects, apiObject)
+			}
+			for path, name := range imports {
+				check.imports[path] = name
+			}
+			return
+		}
+	}
+
+	r.Checks = append(r.Checks, &fieldMappingCheck{
+		Options:    options,
+		APIObjects: []string{apiObject},
+		imports:    imports,
+	})
+}
+
+// fieldMappingCheck is the set of API types converted to or from a resource model with the same AutoFlex options.
+type fieldMappingCheck struct {
+	Options    []string
+	APIObjects []string
+	imports    map[string]string
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+type sourceFile struct {
+	name string
+	file *ast.File
+	src  []byte
+	// imports maps import names to package paths.
+	imports map[string]string
+}
+
+type visitor struct {
+	errs []error
+
+	packageName string
+	files       []*sourceFile
+	// funcDecls are the package's functions (not methods), keyed by name.
+	funcDecls map[string]*ast.FuncDecl
+	resources []*resourceModel
+	// sdkPackages are the type-checked AWS SDK for Go v2 packages, keyed by package path.
+	sdkPackages map[string]*types.Package
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				v.errs = append(v.errs, fmt.Errorf("reading (%s): %w", name, err))
+				continue
+			}
+
+			f := &sourceFile{
+				name:    name,
+				file:    file,
+				src:     src,
+				imports: make(map[string]string),
+			}
+			for _, spec := range file.Imports {
+				path, _ := strconv.Unquote(spec.Path.Value)
+				if spec.Name != nil {
+					f.imports[spec.Name.Name] = path
+				} else {
+					f.imports[importName(path)] = path
+				}
+			}
+			v.files = append(v.files, f)
+
+			for _, decl := range file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+					v.funcDecls[funcDecl.Name.Name] = funcDecl
+				}
+			}
+		}
+	}
+
+	for _, f := range v.files {
+		v.findResourceModels(f)
+	}
+
+	if len(v.resources) == 0 {
+		return
+	}
+
+	for _, f := range v.files {
+		v.findConversions(f)
+	}
+}
+
+// findResourceModels finds the models of resources that embed framework.ResourceWithModel.
+func (v *visitor) findResourceModels(f *sourceFile) {
+	for _, decl := range f.file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			structType, ok := spec.(*ast.TypeSpec).Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			for _, field := range structType.Fields.List {
+				if len(field.Names) > 0 {
+					continue
+				}
+				index, ok := field.Type.(*ast.IndexExpr)
+				if !ok {
+					continue
+				}
+				if pkg, name := f.typeName(index.X); pkg != frameworkPackagePath || name != "ResourceWithModel" {
+					continue
+				}
+				if pkg, name := f.typeName(index.Index); pkg == "" && name != "" {
+					v.resources = append(v.resources, &resourceModel{
+						fileName:  f.name,
+						ModelName: name,
+					})
+				}
+			}
+		}
+	}
+}
+
+// findConversions finds the calls to flex.Expand and flex.Flatten that convert a resource model.
+func (v *visitor) findConversions(f *sourceFile) {
+	for _, decl := range f.file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) < 3 {
+				return true
+			}
+
+			var tfArg, apiArg ast.Expr
+			switch pkg, name := f.typeName(call.Fun); {
+			case pkg == flexPackagePath && name == "Expand":
+				tfArg, apiArg = call.Args[1], call.Args[2]
+			case pkg == flexPackagePath && name == "Flatten":
+				apiArg, tfArg = call.Args[1], call.Args[2]
+			default:
+				return true
+			}
+
+			// Only options that are calls to flex package functions with literal arguments can be reproduced.
+			if call.Ellipsis.IsValid() {
+				return true
+			}
+			var options []string
+			for _, arg := range call.Args[3:] {
+				if !f.isLiteralFlexOption(arg) {
+					return true
+				}
+				options = append(options, f.exprString(arg))
+			}
+
+			tfPkg, tfName := v.exprType(f, funcDecl, tfArg)
+			apiPkg, apiName := v.exprType(f, funcDecl, apiArg)
+			if tfPkg != "" || tfName == "" || !strings.HasPrefix(apiPkg, sdkServicePathPrefix) {
+				return true
+			}
+
+			// Interface types, e.g. union types, can't be instantiated.
+			if !slices.ContainsFunc(v.resources, func(r *resourceModel) bool { return r.ModelName == tfName }) || !v.isStructType(apiPkg, apiName) {
+				return true
+			}
+
+			for _, r := range v.resources {
+				if r.ModelName != tfName {
+					continue
+				}
+
+				alias := f.importAlias(apiPkg)
+				imports := map[string]string{
+					apiPkg: alias,
+				}
+				if len(options) > 0 {
+					imports[flexPackagePath] = f.importAlias(flexPackagePath)
+				}
+
+				r.addCheck(options, alias+"."+apiName+"{}", imports)
+			}
+
+			return true
+		})
+	}
+}
+
+// exprType returns the package path and name of the (pointer-dereferenced) type of an expression
+// used as an argument to flex.Expand or flex.Flatten within `funcDecl`.
+// Local types have an empty package path.
+func (v *visitor) exprType(f *sourceFile, funcDecl *ast.FuncDecl, expr ast.Expr) (string, string) {
+	switch x := expr.(type) {
+	case *ast.ParenExpr:
+		return v.exprType(f, funcDecl, x.X)
+
+	case *ast.UnaryExpr:
+		if x.Op == token.AND {
+			return v.exprType(f, funcDecl, x.X)
+		}
+
+	case *ast.StarExpr:
+		return v.exprType(f, funcDecl, x.X)
+
+	case *ast.CompositeLit:
+		return f.typeName(x.Type)
+
+	case *ast.CallExpr:
+		return v.callResultType(f, funcDecl, x, 0)
+
+	case *ast.Ident:
+		return v.identType(f, funcDecl, x)
+	}
+
+	return "", ""
+}
+
+// identType returns the type of the variable `ident` declared as a parameter of, or within, `funcDecl`.
+func (v *visitor) identType(f *sourceFile, funcDecl *ast.FuncDecl, ident *ast.Ident) (string, string) {
+	for _, field := range funcDecl.Type.Params.List {
+		for _, name := range field.Names {
+			if name.Name == ident.Name {
+				return f.typeName(field.Type)
+			}
+		}
+	}
+
+	var pkg, typ string
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		if node == nil || node.Pos() >= ident.Pos() {
+			return false
+		}
+
+		// Skip declarations that contain the identifier, e.g. `x := f(x)`.
+		if node.End() > ident.Pos() {
+			switch node.(type) {
+			case *ast.ValueSpec, *ast.AssignStmt:
+				return true
+			}
+		}
+
+		switch x := node.(type) {
+		case *ast.ValueSpec:
+			for i, name := range x.Names {
+				if name.Name != ident.Name {
+					continue
+				}
+				if x.Type != nil {
+					pkg, typ = f.typeName(x.Type)
+				} else if len(x.Values) == len(x.Names) {
+					pkg, typ = v.exprType(f, funcDecl, x.Values[i])
+				}
+			}
+
+		case *ast.AssignStmt:
+			if x.Tok != token.DEFINE {
+				break
+			}
+			for i, lhs := range x.Lhs {
+				if name, ok := lhs.(*ast.Ident); !ok || name.Name != ident.Name {
+					continue
+				}
+				switch {
+				case len(x.Rhs) == len(x.Lhs):
+					pkg, typ = v.exprType(f, funcDecl, x.Rhs[i])
+				case len(x.Rhs) == 1:
+					if call, ok := x.Rhs[0].(*ast.CallExpr); ok {
+						pkg, typ = v.callResultType(f, funcDecl, call, i)
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	return pkg, typ
+}
+
+// callResultType returns the type of result `index` of the call `call`.
+// Results of local functions and of AWS SDK for Go v2 client operations are recognized.
+func (v *visitor) callResultType(f *sourceFile, funcDecl *ast.FuncDecl, call *ast.CallExpr, index int) (string, string) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if funcDecl, ok := v.funcDecls[fun.Name]; ok && funcDecl.Type.Results != nil {
+			var results []ast.Expr
+			for _, field := range funcDecl.Type.Results.List {
+				for range max(1, len(field.Names)) {
+					results = append(results, field.Type)
+				}
+			}
+			if index < len(results) {
+				return v.fileOf(funcDecl).typeName(results[index])
+			}
+		}
+
+	case *ast.SelectorExpr:
+		// A method call whose input is the operation's input type is an API operation,
+		// e.g. `conn.CreateQueue(ctx, &input)` where `input` is a `sqs.CreateQueueInput`.
+		if _, isImport := f.imports[exprName(fun.X)]; isImport || index != 0 || len(call.Args) < 2 {
+			break
+		}
+		if pkg, typ := v.exprType(f, funcDecl, call.Args[1]); strings.HasPrefix(pkg, sdkServicePathPrefix) && typ == fun.Sel.Name+"Input" {
+			return pkg, fun.Sel.Name + "Output"
+		}
+	}
+
+	return "", ""
+}
+
+// isStructType returns whether the type `name` in the AWS SDK for Go v2 package with path `pkgPath` is a struct type.
+func (v *visitor) isStructType(pkgPath, name string) bool {
+	pkg, ok := v.sdkPackages[pkgPath]
+	if !ok {
+		cfg := &packages.Config{
+			Mode: packages.NeedName | packages.NeedTypes | packages.NeedImports | packages.NeedDeps,
+		}
+		pkgs, err := packages.Load(cfg, pkgPath)
+		if err == nil && len(pkgs) != 1 {
+			err = fmt.Errorf("%d packages found", len(pkgs))
+		}
+		if err != nil {
+			v.errs = append(v.errs, fmt.Errorf("loading (%s): %w", pkgPath, err))
+		} else {
+			pkg = pkgs[0].Types
+		}
+		v.sdkPackages[pkgPath] = pkg
+	}
+
+	if pkg == nil {
+		return false
+	}
+
+	obj := pkg.Scope().Lookup(name)
+	if obj == nil {
+		v.errs = append(v.errs, fmt.Errorf("type %s.%s not found", pkgPath, name))
+		return false
+	}
+
+	_, ok = obj.Type().Underlying().(*types.Struct)
+
+	return ok
+}
+
+func (v *visitor) fileOf(funcDecl *ast.FuncDecl) *sourceFile {
+	for _, f := range v.files {
+		if f.file.Pos() <= funcDecl.Pos() && funcDecl.End() <= f.file.End() {
+			return f
+		}
+	}
+
+	return &sourceFile{}
+}
+
+// typeName returns the package path and name of a (pointer-dereferenced) type expression.
+// Local types have an empty package path.
+func (f *sourceFile) typeName(expr ast.Expr) (string, string) {
+	switch x := expr.(type) {
+	case *ast.StarExpr:
+		return f.typeName(x.X)
+
+	case *ast.Ident:
+		return "", x.Name
+
+	case *ast.SelectorExpr:
+		if pkg, ok := x.X.(*ast.Ident); ok {
+			if path, ok := f.imports[pkg.Name]; ok {
+				return path, x.Sel.Name
+			}
+		}
+	}
+
+	return "", ""
+}
+
+// isLiteralFlexOption returns whether `expr` is a call to a flex package function with only literal arguments.
+func (f *sourceFile) isLiteralFlexOption(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	if pkg, _ := f.typeName(call.Fun); pkg != flexPackagePath {
+		return false
+	}
+	for _, arg := range call.Args {
+		if _, ok := arg.(*ast.BasicLit); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// importAlias returns the name under which the file imports the package path `path`.
+func (f *sourceFile) importAlias(path string) string {
+	for name, v := range f.imports {
+		if v == path {
+			return name
+		}
+	}
+
+	return importName(path)
+}
+
+// exprString returns the source text of the expression `expr`.
+func (f *sourceFile) exprString(expr ast.Expr) string {
+	return string(f.src[expr.Pos()-f.file.FileStart : expr.End()-f.file.FileStart])
+}
+
+func exprName(expr ast.Expr) string {
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// importName returns the default name of the package with path `path`.
+func importName(p string) string {
+	return path.Base(p)
+}
@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// IsARNIdentifiedFrameworkResource returns whether the Terraform Plugin Framework resource returned by the specified factory function
+// is identified solely by its ARN, and so can use ARN-based resource identity and the generic ARN importer.
+// The resource type and its methods must be declared in the same file as the factory function.
+//
+// A resource is ARN-identified if
+//   - its ImportState method passes the import ID through to the `arn` attribute,
+//   - every finder (`find...` function) called from its Read method is passed only values derived from ARN-valued model fields, and
+//   - its schema does not define an `id` attribute.
+func IsARNIdentifiedFrameworkResource(file *ast.File, factory *ast.FuncDecl) bool {
+	typeName := frameworkResourceTypeName(factory)
+	if typeName == "" {
+		return false
+	}
+
+	methods := make(map[string]*ast.FuncDecl)
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv != nil && funcDecl.Body != nil && receiverTypeName(funcDecl) == typeName {
+			methods[funcDecl.Name.Name] = funcDecl
+		}
+	}
+
+	importState, read, schema := methods["ImportState"], methods["Read"], methods["Schema"]
+	if importState == nil || read == nil || schema == nil {
+		return false
+	}
+
+	return isImportStatePassthroughARN(importState) && isReadByARNOnly(read) && !schemaDefinesID(schema)
+}
+
+// frameworkResourceTypeName returns the name of the resource type instantiated by a factory function,
+// e.g. "exampleResource" for `r := &exampleResource{}`.
+func frameworkResourceTypeName(factory *ast.FuncDecl) string {
+	if factory.Body == nil {
+		return ""
+	}
+
+	var typeName string
+	ast.Inspect(factory.Body, func(n ast.Node) bool {
+		if typeName != "" {
+			return false
+		}
+		if unary, ok := n.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			if lit, ok := unary.X.(*ast.CompositeLit); ok {
+				if ident, ok := lit.Type.(*ast.Ident); ok {
+					typeName = ident.Name
+				}
+			}
+		}
+		return true
+	})
+
+	return typeName
+}
+
+func receiverTypeName(funcDecl *ast.FuncDecl) string {
+	if len(funcDecl.Recv.List) != 1 {
+		return ""
+	}
+
+	typ := funcDecl.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// isImportStatePassthroughARN returns whether the ImportState method's body is
+// `resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)`.
+func isImportStatePassthroughARN(funcDecl *ast.FuncDecl) bool {
+	if len(funcDecl.Body.List) != 1 {
+		return false
+	}
+
+	stmt, ok := funcDecl.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || !isSelector(call.Fun, "resource", "ImportStatePassthroughID") || len(call.Args) != 4 {
+		return false
+	}
+	root, ok := call.Args[1].(*ast.CallExpr)
+	if !ok || !isSelector(root.Fun, "path", "Root") || len(root.Args) != 1 {
+		return false
+	}
+
+	return isAttributeName(root.Args[0], "arn")
+}
+
+// isReadByARNOnly returns whether all finders called from the Read method are passed only ARN values.
+func isReadByARNOnly(funcDecl *ast.FuncDecl) bool {
+	// Local variables and the expressions assigned to them.
+	assignments := make(map[string][]ast.Expr)
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+			for i, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					assignments[ident.Name] = append(assignments[ident.Name], assign.Rhs[i])
+				}
+			}
+		}
+		return true
+	})
+
+	var nFinders int
+	isARNOnly := true
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		if !ok || !strings.HasPrefix(ident.Name, "find") {
+			return true
+		}
+
+		nFinders++
+		// Skip the Context and API client arguments.
+		if len(call.Args) < 3 {
+			isARNOnly = false
+			return false
+		}
+		for _, arg := range call.Args[2:] {
+			if !isARNValue(arg, assignments, make(map[string]bool)) {
+				isARNOnly = false
+			}
+		}
+
+		return false
+	})
+
+	return nFinders > 0 && isARNOnly
+}
+
+// isARNValue returns whether the expression's value is derived only from ARN-valued model fields, e.g.
+// `data.ARN.ValueString()` or `fwflex.StringValueFromFramework(ctx, data.PolicyARN)`.
+func isARNValue(expr ast.Expr, assignments map[string][]ast.Expr, seen map[string]bool) bool {
+	var nFields int
+	isARN := true
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			// Look at the receiver of method calls and the arguments, not the function itself.
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok {
+				if _, ok := sel.X.(*ast.Ident); !ok {
+					ast.Inspect(sel.X, func(n ast.Node) bool {
+						return inspectARNField(n, &nFields, &isARN)
+					})
+				}
+			}
+			for _, arg := range n.Args {
+				if ident, ok := arg.(*ast.Ident); ok && ident.Name == "ctx" {
+					continue
+				}
+				if !isARNValue(arg, assignments, seen) {
+					isARN = false
+				}
+				nFields++
+			}
+			return false
+		case *ast.Ident:
+			rhs, ok := assignments[n.Name]
+			if !ok || seen[n.Name] {
+				isARN = false
+				return false
+			}
+			seen[n.Name] = true
+			for _, expr := range rhs {
+				if !isARNValue(expr, assignments, seen) {
+					isARN = false
+				}
+			}
+			nFields++
+			return false
+		default:
+			return inspectARNField(n, &nFields, &isARN)
+		}
+	})
+
+	return nFields > 0 && isARN
+}
+
+func inspectARNField(n ast.Node, nFields *int, isARN *bool) bool {
+	switch n := n.(type) {
+	case *ast.SelectorExpr:
+		if _, ok := n.X.(*ast.Ident); ok {
+			*nFields++
+			if !strings.HasSuffix(n.Sel.Name, "ARN") {
+				*isARN = false
+			}
+			return false
+		}
+	case *ast.BasicLit:
+		*isARN = false
+		return false
+	}
+
+	return true
+}
+
+// schemaDefinesID returns whether the Schema method defines a top-level `id` attribute.
+func schemaDefinesID(funcDecl *ast.FuncDecl) bool {
+	var definesID bool
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if kv, ok := n.(*ast.KeyValueExpr); ok && isAttributeName(kv.Key, "id") {
+			definesID = true
+		}
+		return !definesID
+	})
+
+	return definesID
+}
+
+// isAttributeName returns whether the expression is the specified attribute name,
+// either as a string literal or as the corresponding constant in the `names` package.
+func isAttributeName(expr ast.Expr, name string) bool {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			if s, err := strconv.Unquote(expr.Value); err == nil {
+				return s == name
+			}
+		}
+	case *ast.SelectorExpr:
+		if ident, ok := expr.X.(*ast.Ident); ok && ident.Name == "names" {
+			return expr.Sel.Name == "Attr"+strings.ToUpper(name)
+		}
+	}
+
+	return false
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
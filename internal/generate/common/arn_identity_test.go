@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const arnIdentityTestSource = `package example
+
+// @FrameworkResource("aws_example_thing", name="Thing")
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &thingResource{}
+
+	return r, nil
+}
+
+type thingResource struct {
+	framework.ResourceWithModel[thingResourceModel]
+}
+
+func (r *thingResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:  framework.ARNAttributeComputedOnly(),
+			names.AttrName: schema.StringAttribute{Required: true},
+		},
+	}
+}
+
+func (r *thingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data thingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	conn := r.Meta().ExampleClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findThingByARN(ctx, conn, arn)
+	_ = output
+	_ = err
+}
+
+func (r *thingResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+`
+
+func TestIsARNIdentifiedFrameworkResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		source   string
+		expected bool
+	}{
+		"ARN identified": {
+			source:   arnIdentityTestSource,
+			expected: true,
+		},
+		"string literal attribute name": {
+			source: replaceSource(arnIdentityTestSource,
+				`path.Root(names.AttrARN)`, `path.Root("arn")`,
+			),
+			expected: true,
+		},
+		"import by name": {
+			source: replaceSource(arnIdentityTestSource,
+				`path.Root(names.AttrARN)`, `path.Root(names.AttrName)`,
+			),
+		},
+		"custom import": {
+			source: replaceSource(arnIdentityTestSource,
+				`	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)`, `	parts := strings.Split(request.ID, ",")
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)`,
+			),
+		},
+		"find by name": {
+			source: replaceSource(arnIdentityTestSource,
+				`data.ARN)`, `data.Name)`,
+			),
+		},
+		"find by ARN and literal": {
+			source: replaceSource(arnIdentityTestSource,
+				`findThingByARN(ctx, conn, arn)`, `findThingByTwoPartKey(ctx, conn, arn, "default")`,
+			),
+		},
+		"find by ARN field": {
+			source: replaceSource(arnIdentityTestSource,
+				`findThingByARN(ctx, conn, arn)`, `findThingByARN(ctx, conn, data.ThingARN.ValueString())`,
+			),
+			expected: true,
+		},
+		"no finder": {
+			source: replaceSource(arnIdentityTestSource,
+				`findThingByARN(ctx, conn, arn)`, `conn.GetThing(ctx, arn)`,
+			),
+		},
+		"id attribute": {
+			source: replaceSource(arnIdentityTestSource,
+				`names.AttrName: schema.StringAttribute{Required: true},`, `names.AttrID:   framework.IDAttribute(),`,
+			),
+		},
+		"no ImportState": {
+			source: replaceSource(arnIdentityTestSource,
+				`func (r *thingResource) ImportState(`, `func (r *thingResource) importState(`,
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := parser.ParseFile(token.NewFileSet(), "thing.go", testCase.source, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("parsing source: %s", err)
+			}
+
+			var factory *ast.FuncDecl
+			for _, decl := range file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Name.Name == "newThingResource" {
+					factory = funcDecl
+				}
+			}
+
+			if got, want := IsARNIdentifiedFrameworkResource(file, factory), testCase.expected; got != want {
+				t.Errorf("IsARNIdentifiedFrameworkResource() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func replaceSource(source, old, new string) string {
+	if !strings.Contains(source, old) {
+		panic("source does not contain " + old)
+	}
+
+	return strings.Replace(source, old, new, 1)
+}
@@ -142,32 +142,16 @@ func main() {
 			g.Fatalf("generating file (%s): %s", filename, err)
 		}
 
-		basicConfigTmplFile := fmt.Sprintf("%s_basic.gtpl", sourceName)
-		basicConfigTmplPath := path.Join("testdata", "tmpl", basicConfigTmplFile)
-		var configTmplFile string
-		var configTmplPath string
-		if _, err := os.Stat(basicConfigTmplPath); err == nil {
-			configTmplFile = basicConfigTmplFile
-			configTmplPath = basicConfigTmplPath
-		} else if !errors.Is(err, os.ErrNotExist) {
-			g.Fatalf("accessing config template %q: %w", basicConfigTmplPath, err)
-		}
-
-		tagsConfigTmplFile := fmt.Sprintf("%s_tags.gtpl", sourceName)
-		tagsConfigTmplPath := path.Join("testdata", "tmpl", tagsConfigTmplFile)
-		if configTmplPath == "" {
-			if _, err := os.Stat(tagsConfigTmplPath); err == nil {
-				configTmplFile = tagsConfigTmplFile
-				configTmplPath = tagsConfigTmplPath
-			} else if !errors.Is(err, os.ErrNotExist) {
-				g.Fatalf("accessing config template %q: %w", tagsConfigTmplPath, err)
-			}
+		configTmplFile, err := findConfigTemplate(sourceName)
+		if err != nil {
+			g.Fatalf("%s", err)
 		}
 
-		if configTmplPath == "" {
-			g.Errorf("no config template found for %q at %q or %q", sourceName, basicConfigTmplPath, tagsConfigTmplPath)
+		if configTmplFile == "" {
+			g.Errorf("no config template found for %q at %q or %q", sourceName, configTemplatePath(sourceName, "basic"), configTemplatePath(sourceName, "tags"))
 			continue
 		}
+		configTmplPath := path.Join("testdata", "tmpl", configTmplFile)
 
 		b, err := os.ReadFile(configTmplPath)
 		if err != nil {
@@ -472,6 +456,7 @@ type visitor struct {
 	errs []error
 	g    *common.Generator
 
+	file         *ast.File
 	fileName     string
 	functionName string
 	packageName  string
@@ -510,7 +495,11 @@ func (v *visitor) processDir(path string) {
 
 // processFile processes a single Go source file.
 func (v *visitor) processFile(file *ast.File) {
+	v.file = file
+
 	ast.Walk(v, file)
+
+	v.file = nil
 }
 
 // processFuncDecl processes a single Go function.
@@ -526,6 +515,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		IdentityVersions:      make(map[int64]*version.Version, 0),
 	}
 	skip := false
+	noIdentity := false
 	tlsKey := false
 	var tlsKeyCN string
 
@@ -598,6 +588,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					}
 				}
 
+			case "NoIdentity":
+				noIdentity = true
+
 			case "NoImport":
 				d.NoImport = true
 
@@ -741,6 +734,25 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		d.HasRegionOverrideTest = false
 	}
 
+	// Framework resources that are identified solely by their ARN get ARN-based resource identity by default.
+	if d.Implementation == common.ImplementationFramework && !noIdentity && !d.NoImport && !d.HasResourceIdentity() {
+		if common.IsARNIdentifiedFrameworkResource(v.file, funcDecl) {
+			if err := common.ParseResourceIdentity("ArnIdentity", common.ParseArgs(""), d.Implementation, &d.ResourceIdentity, &d.GoImports); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
+				return
+			}
+
+			sourceName := strings.TrimSuffix(strings.TrimSuffix(v.fileName, filepath.Ext(v.fileName)), "_")
+			if configTmplFile, err := findConfigTemplate(sourceName); err != nil {
+				v.errs = append(v.errs, err)
+				return
+			} else if configTmplFile == "" {
+				v.g.Infof("Skipping Identity test for %s.%s: no config template", v.packageName, v.functionName)
+				skip = true
+			}
+		}
+	}
+
 	if d.HasResourceIdentity() {
 		if !skip {
 			if err := tests.Configure(&d.CommonArgs); err != nil {
@@ -785,6 +797,26 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = ""
 }
 
+// configTemplatePath returns the path of the named test configuration template for a source file.
+func configTemplatePath(sourceName, name string) string {
+	return path.Join("testdata", "tmpl", fmt.Sprintf("%s_%s.gtpl", sourceName, name))
+}
+
+// findConfigTemplate returns the file name of the test configuration template for a source file,
+// or an empty string if there is none.
+func findConfigTemplate(sourceName string) (string, error) {
+	for _, name := range []string{"basic", "tags"} {
+		tmplPath := configTemplatePath(sourceName, name)
+		if _, err := os.Stat(tmplPath); err == nil {
+			return path.Base(tmplPath), nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("accessing config template %q: %w", tmplPath, err)
+		}
+	}
+
+	return "", nil
+}
+
 // Visit is called for each node visited by ast.Walk.
 func (v *visitor) Visit(node ast.Node) ast.Visitor {
 	// Look at functions (not methods) with comments.
@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/dlclark/regexp2" // Regexps include Perl syntax.
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/tests"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating Plugin SDK V2 to Plugin Framework migration tests for internal/service/%s", servicePackage)
+
+	var (
+		svc   serviceRecords
+		found bool
+	)
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		if p := l.SplitPackageRealPackage(); p != "" {
+			if p != servicePackage {
+				continue
+			}
+
+			ep := l.ProviderPackage()
+			if p == ep {
+				svc.primary = l
+				found = true
+			} else {
+				svc.additional = append(svc.additional, l)
+			}
+		} else {
+			p := l.ProviderPackage()
+
+			if p != servicePackage {
+				continue
+			}
+
+			svc.primary = l
+			found = true
+		}
+	}
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.migratedResources {
+		resource.service = &svc
+
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		filename := fmt.Sprintf("%s_migration_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+
+		templates := template.New("migrationtests")
+
+		templates, err = tests.AddCommonResourceTestTemplates(templates)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		templates, err = templates.Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		configTmplFile := fmt.Sprintf("%s_basic.gtpl", sourceName)
+		configTmplPath := path.Join("testdata", "tmpl", configTmplFile)
+		b, err := os.ReadFile(configTmplPath)
+		if errors.Is(err, os.ErrNotExist) {
+			g.Errorf("no config template found for %q at %q", sourceName, configTmplPath)
+			continue
+		} else if err != nil {
+			g.Fatalf("reading config template %q: %s", configTmplPath, err)
+		}
+		configTmpl := string(b)
+
+		additionalTfVars := tfmaps.Keys(resource.AdditionalTfVars_)
+		slices.Sort(additionalTfVars)
+		testDirPath := path.Join("testdata", resource.Name)
+
+		tfTemplates, err := template.New("migrationtests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		tfTemplates, err = tests.AddCommonTfTemplates(tfTemplates)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		_, err = tfTemplates.New("body").Parse(configTmpl)
+		if err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplPath, err)
+		}
+
+		_, err = tfTemplates.New("region").Parse("")
+		if err != nil {
+			g.Fatalf("parsing config template: %s", err)
+		}
+
+		commonConfig := commonConfig{
+			AdditionalTfVars: additionalTfVars,
+			RequiredEnvVars:  resource.RequiredEnvVars,
+			WithRName:        (resource.Generator != ""),
+		}
+
+		generateTestConfig(g, testDirPath, "basic", tfTemplates, commonConfig)
+
+		commonConfig.ExternalProviders = map[string]requiredProvider{
+			"aws": {
+				Source:  "hashicorp/aws",
+				Version: resource.MigratedFromPluginSDKVersion.String(),
+			},
+		}
+
+		generateTestConfig(g, testDirPath, fmt.Sprintf("basic_v%s", resource.MigratedFromPluginSDKVersion.String()), tfTemplates, commonConfig)
+	}
+}
+
+type serviceRecords struct {
+	primary    data.ServiceRecord
+	additional []data.ServiceRecord
+}
+
+func (sr serviceRecords) ProviderPackage() string {
+	return sr.primary.ProviderPackage()
+}
+
+func (sr serviceRecords) ProviderNameUpper(typeName string) (string, error) {
+	if len(sr.additional) == 0 {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	for _, svc := range sr.additional {
+		if match, err := resourceTypeNameMatchesService(typeName, svc); err != nil {
+			return "", err
+		} else if match {
+			return svc.ProviderNameUpper(), nil
+		}
+	}
+
+	if match, err := resourceTypeNameMatchesService(typeName, sr.primary); err != nil {
+		return "", err
+	} else if match {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	return "", fmt.Errorf("No match found for resource type %q", typeName)
+}
+
+func resourceTypeNameMatchesService(typeName string, sr data.ServiceRecord) (bool, error) {
+	prefixActual := sr.ResourcePrefixActual()
+	if prefixActual != "" {
+		if match, err := resourceTypeNameMatchesPrefix(typeName, prefixActual); err != nil {
+			return false, err
+		} else if match {
+			return true, nil
+		}
+	}
+
+	if match, err := resourceTypeNameMatchesPrefix(typeName, sr.ResourcePrefixCorrect()); err != nil {
+		return false, err
+	} else if match {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func resourceTypeNameMatchesPrefix(typeName, typePrefix string) (bool, error) {
+	re, err := regexp2.Compile(typePrefix, 0)
+	if err != nil {
+		return false, err
+	}
+	match, err := re.MatchString(typeName)
+	if err != nil {
+		return false, err
+	}
+	return match, err
+}
+
+func (sr serviceRecords) PackageProviderNameUpper() string {
+	return sr.primary.ProviderNameUpper()
+}
+
+type ResourceDatum struct {
+	service  *serviceRecords
+	FileName string
+	tests.CommonArgs
+}
+
+func (d ResourceDatum) ProviderPackage() string {
+	return d.service.ProviderPackage()
+}
+
+func (d ResourceDatum) ResourceProviderNameUpper() (string, error) {
+	return d.service.ProviderNameUpper(d.TypeName)
+}
+
+func (d ResourceDatum) PackageProviderNameUpper() string {
+	return d.service.PackageProviderNameUpper()
+}
+
+type commonConfig struct {
+	AdditionalTfVars  []string
+	WithRName         bool
+	WithRegion        bool
+	ExternalProviders map[string]requiredProvider
+	RequiredEnvVars   []string
+}
+
+type requiredProvider struct {
+	Source  string
+	Version string
+}
+
+type ConfigDatum struct {
+	commonConfig
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	migratedResources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework resource
+// that has been migrated from Plugin SDK V2.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		FileName:   v.fileName,
+		CommonArgs: tests.InitCommonArgs(),
+	}
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName, args := m[1], common.ParseArgs(m[3]); annotationName {
+			case "FrameworkResource":
+				d.Implementation = common.ImplementationFramework
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+
+				if attr, ok := args.Keyword["name"]; ok {
+					attr = strings.ReplaceAll(attr, " ", "")
+					d.Name = strings.ReplaceAll(attr, "-", "")
+				}
+
+			case "Testing":
+				if err := tests.ParseTestingAnnotations(args, &d.CommonArgs); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("%s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+					continue
+				}
+			}
+		}
+	}
+
+	if d.MigratedFromPluginSDKVersion != nil {
+		if d.Implementation != common.ImplementationFramework {
+			v.errs = append(v.errs, fmt.Errorf("migratedFromPluginSDKVersion is only valid for Plugin Framework resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			return
+		}
+
+		if d.UseAlternateAccount {
+			v.errs = append(v.errs, fmt.Errorf("migratedFromPluginSDKVersion is not supported with useAlternateAccount: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			return
+		}
+
+		if err := tests.Configure(&d.CommonArgs); err != nil {
+			v.errs = append(v.errs, fmt.Errorf("%s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+			return
+		}
+
+		v.migratedResources = append(v.migratedResources, d)
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+func generateTestConfig(g *common.Generator, dirPath, test string, tfTemplates *template.Template, config commonConfig) {
+	dirPath = path.Join(dirPath, test)
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	configData := ConfigDatum{
+		commonConfig: config,
+	}
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
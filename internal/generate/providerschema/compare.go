@@ -0,0 +1,329 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package providerschema
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Kinds of schema.
+const (
+	KindProvider          = "provider"
+	KindResource          = "resource"
+	KindDataSource        = "data source"
+	KindEphemeralResource = "ephemeral resource"
+	KindListResource      = "list resource"
+	KindAction            = "action"
+	KindFunction          = "function"
+)
+
+// Change is a single difference between two snapshots.
+type Change struct {
+	Kind     string
+	TypeName string
+	// Path is the attribute or block path, e.g. "rule.action". Empty for changes to the whole type.
+	Path     string
+	Breaking bool
+	Message  string
+}
+
+func (c Change) String() string {
+	if c.Path == "" {
+		return fmt.Sprintf("%s %s: %s", c.Kind, c.TypeName, c.Message)
+	}
+
+	return fmt.Sprintf("%s %s: %s: %s", c.Kind, c.TypeName, c.Path, c.Message)
+}
+
+// Compare returns the differences between an old and a new snapshot, classified as breaking or non-breaking.
+// Changes are sorted by kind, type name and path.
+func Compare(old, new *Snapshot) []Change {
+	var changes []Change
+
+	if old.Provider != nil && new.Provider != nil {
+		changes = append(changes, compareSchema(KindProvider, "aws", old.Provider, new.Provider)...)
+	}
+	changes = append(changes, compareSchemas(KindResource, old.Resources, new.Resources)...)
+	changes = append(changes, compareSchemas(KindDataSource, old.DataSources, new.DataSources)...)
+	changes = append(changes, compareSchemas(KindEphemeralResource, old.EphemeralResources, new.EphemeralResources)...)
+	changes = append(changes, compareSchemas(KindListResource, old.ListResources, new.ListResources)...)
+	changes = append(changes, compareSchemas(KindAction, old.Actions, new.Actions)...)
+	changes = append(changes, compareFunctions(old.Functions, new.Functions)...)
+
+	slices.SortStableFunc(changes, func(a, b Change) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.TypeName, b.TypeName),
+			cmp.Compare(a.Path, b.Path),
+		)
+	})
+
+	return changes
+}
+
+// Breaking returns only the breaking changes.
+func Breaking(changes []Change) []Change {
+	return slices.DeleteFunc(slices.Clone(changes), func(c Change) bool {
+		return !c.Breaking
+	})
+}
+
+func compareSchemas(kind string, old, new map[string]*Schema) []Change {
+	var changes []Change
+
+	for _, typeName := range unionKeys(old, new) {
+		o, n := old[typeName], new[typeName]
+
+		switch {
+		case n == nil:
+			changes = append(changes, Change{Kind: kind, TypeName: typeName, Breaking: true, Message: "removed"})
+		case o == nil:
+			changes = append(changes, Change{Kind: kind, TypeName: typeName, Message: "added"})
+		default:
+			changes = append(changes, compareSchema(kind, typeName, o, n)...)
+		}
+	}
+
+	return changes
+}
+
+func compareSchema(kind, typeName string, old, new *Schema) []Change {
+	var changes []Change
+
+	if old.Version != new.Version {
+		// A schema version increase requires a state upgrader, which is verified by acceptance tests.
+		changes = append(changes, Change{Kind: kind, TypeName: typeName, Breaking: new.Version < old.Version, Message: fmt.Sprintf("schema version changed from %d to %d", old.Version, new.Version)})
+	}
+
+	c := comparer{kind: kind, typeName: typeName}
+	c.compareBlock("", old.Block, new.Block)
+
+	return append(changes, c.changes...)
+}
+
+type comparer struct {
+	kind     string
+	typeName string
+	changes  []Change
+}
+
+func (c *comparer) add(path string, breaking bool, format string, a ...any) {
+	c.changes = append(c.changes, Change{Kind: c.kind, TypeName: c.typeName, Path: path, Breaking: breaking, Message: fmt.Sprintf(format, a...)})
+}
+
+func (c *comparer) compareBlock(path string, old, new *Block) {
+	if old == nil {
+		old = &Block{}
+	}
+	if new == nil {
+		new = &Block{}
+	}
+
+	if !old.Deprecated && new.Deprecated {
+		c.add(path, false, "deprecated")
+	}
+
+	for _, name := range unionKeys(old.Attributes, new.Attributes) {
+		c.compareAttribute(joinPath(path, name), old.Attributes[name], new.Attributes[name])
+	}
+
+	for _, name := range unionKeys(old.Blocks, new.Blocks) {
+		c.compareNestedBlock(joinPath(path, name), old.Blocks[name], new.Blocks[name])
+	}
+}
+
+func (c *comparer) compareAttribute(path string, old, new *Attribute) {
+	switch {
+	case new == nil:
+		c.add(path, true, "attribute removed")
+		return
+	case old == nil:
+		switch {
+		case new.Required:
+			c.add(path, true, "required attribute added")
+		case new.Optional:
+			c.add(path, false, "optional attribute added")
+		default:
+			c.add(path, false, "computed attribute added")
+		}
+		return
+	}
+
+	if !bytes.Equal(old.Type, new.Type) {
+		c.add(path, true, "type changed from %s to %s", old.Type, new.Type)
+	}
+
+	oldMode, newMode := old.mode(), new.mode()
+	if oldMode != newMode {
+		c.add(path, isBreakingModeChange(oldMode, newMode), "changed from %s to %s", oldMode, newMode)
+	}
+
+	switch {
+	case !old.RequiresReplace && new.RequiresReplace:
+		c.add(path, true, "now forces replacement")
+	case old.RequiresReplace && !new.RequiresReplace:
+		c.add(path, false, "no longer forces replacement")
+	}
+
+	if old.WriteOnly != new.WriteOnly {
+		c.add(path, true, "write-only changed from %t to %t", old.WriteOnly, new.WriteOnly)
+	}
+
+	if old.Sensitive != new.Sensitive {
+		c.add(path, false, "sensitive changed from %t to %t", old.Sensitive, new.Sensitive)
+	}
+
+	if !old.Deprecated && new.Deprecated {
+		c.add(path, false, "deprecated")
+	}
+}
+
+func (c *comparer) compareNestedBlock(path string, old, new *NestedBlock) {
+	switch {
+	case new == nil:
+		c.add(path, true, "block removed")
+		return
+	case old == nil:
+		if new.MinItems > 0 {
+			c.add(path, true, "required block added")
+		} else {
+			c.add(path, false, "block added")
+		}
+		return
+	}
+
+	if old.Nesting != new.Nesting {
+		c.add(path, true, "nesting mode changed from %s to %s", old.Nesting, new.Nesting)
+	}
+
+	if old.MinItems != new.MinItems {
+		c.add(path, new.MinItems > old.MinItems, "minimum items changed from %d to %d", old.MinItems, new.MinItems)
+	}
+
+	if old.MaxItems != new.MaxItems {
+		// 0 means no limit.
+		breaking := new.MaxItems != 0 && (old.MaxItems == 0 || new.MaxItems < old.MaxItems)
+		c.add(path, breaking, "maximum items changed from %d to %d", old.MaxItems, new.MaxItems)
+	}
+
+	switch {
+	case !old.RequiresReplace && new.RequiresReplace:
+		c.add(path, true, "now forces replacement")
+	case old.RequiresReplace && !new.RequiresReplace:
+		c.add(path, false, "no longer forces replacement")
+	}
+
+	c.compareBlock(path, old.Block, new.Block)
+}
+
+// Attribute configurability modes.
+const (
+	modeRequired         = "required"
+	modeOptional         = "optional"
+	modeOptionalComputed = "optional+computed"
+	modeComputed         = "computed"
+)
+
+func (a *Attribute) mode() string {
+	switch {
+	case a.Required:
+		return modeRequired
+	case a.Optional && a.Computed:
+		return modeOptionalComputed
+	case a.Optional:
+		return modeOptional
+	default:
+		return modeComputed
+	}
+}
+
+func isBreakingModeChange(old, new string) bool {
+	switch new {
+	case modeRequired:
+		// Existing configurations may not set the attribute.
+		return true
+	case modeComputed:
+		// Existing configurations that set the attribute are now invalid.
+		return true
+	case modeOptional:
+		// Computed values that were not configured are now removed.
+		return old == modeOptionalComputed || old == modeComputed
+	default:
+		return false
+	}
+}
+
+func compareFunctions(old, new map[string]*Function) []Change {
+	var changes []Change
+
+	for _, name := range unionKeys(old, new) {
+		o, n := old[name], new[name]
+
+		add := func(breaking bool, format string, a ...any) {
+			changes = append(changes, Change{Kind: KindFunction, TypeName: name, Breaking: breaking, Message: fmt.Sprintf(format, a...)})
+		}
+
+		switch {
+		case n == nil:
+			add(true, "removed")
+			continue
+		case o == nil:
+			add(false, "added")
+			continue
+		}
+
+		if len(o.Parameters) != len(n.Parameters) {
+			add(true, "number of parameters changed from %d to %d", len(o.Parameters), len(n.Parameters))
+		} else {
+			for i := range o.Parameters {
+				if !bytes.Equal(o.Parameters[i].Type, n.Parameters[i].Type) {
+					add(true, "parameter %d (%s) type changed from %s to %s", i, n.Parameters[i].Name, o.Parameters[i].Type, n.Parameters[i].Type)
+				}
+			}
+		}
+
+		switch {
+		case o.VariadicParameter != nil && n.VariadicParameter == nil:
+			add(true, "variadic parameter removed")
+		case o.VariadicParameter == nil && n.VariadicParameter != nil:
+			add(false, "variadic parameter added")
+		case o.VariadicParameter != nil && !bytes.Equal(o.VariadicParameter.Type, n.VariadicParameter.Type):
+			add(true, "variadic parameter type changed from %s to %s", o.VariadicParameter.Type, n.VariadicParameter.Type)
+		}
+
+		if !bytes.Equal(o.Return, n.Return) {
+			add(true, "return type changed from %s to %s", o.Return, n.Return)
+		}
+
+		if !o.Deprecated && n.Deprecated {
+			add(false, "deprecated")
+		}
+	}
+
+	return changes
+}
+
+func unionKeys[V any](old, new map[string]V) []string {
+	keys := slices.Collect(maps.Keys(old))
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
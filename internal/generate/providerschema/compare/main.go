@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/providerschema"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <old-snapshot> <new-snapshot>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	var breakingOnly bool
+	flag.BoolVar(&breakingOnly, "breaking-only", false, "only report breaking changes")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() != 2 { //nolint:mnd // old and new snapshot
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	g := common.NewGenerator()
+
+	old, err := providerschema.ReadFile(flag.Arg(0))
+	if err != nil {
+		g.Fatalf("reading old snapshot: %s", err)
+	}
+
+	new, err := providerschema.ReadFile(flag.Arg(1))
+	if err != nil {
+		g.Fatalf("reading new snapshot: %s", err)
+	}
+
+	changes := providerschema.Compare(old, new)
+	breaking := providerschema.Breaking(changes)
+
+	if len(breaking) > 0 {
+		g.Infof("Breaking changes:")
+		for _, v := range breaking {
+			g.Infof("  - %s", v)
+		}
+	}
+
+	if !breakingOnly {
+		var nonBreaking []providerschema.Change
+		for _, v := range changes {
+			if !v.Breaking {
+				nonBreaking = append(nonBreaking, v)
+			}
+		}
+
+		if len(nonBreaking) > 0 {
+			g.Infof("Non-breaking changes:")
+			for _, v := range nonBreaking {
+				g.Infof("  - %s", v)
+			}
+		}
+	}
+
+	g.Infof("%d breaking and %d non-breaking changes", len(breaking), len(changes)-len(breaking))
+
+	if len(breaking) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package providerschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	typeString := json.RawMessage(`"string"`)
+	typeNumber := json.RawMessage(`"number"`)
+
+	resource := func(attributes map[string]*Attribute, blocks map[string]*NestedBlock) *Snapshot {
+		return &Snapshot{
+			FormatVersion: FormatVersion,
+			Resources: map[string]*Schema{
+				"aws_test": {
+					Block: &Block{
+						Attributes: attributes,
+						Blocks:     blocks,
+					},
+				},
+			},
+		}
+	}
+
+	testcases := map[string]struct {
+		old, new *Snapshot
+		expected []Change
+	}{
+		"no changes": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Required: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Required: true}}, nil),
+		},
+		"resource removed": {
+			old: resource(nil, nil),
+			new: &Snapshot{FormatVersion: FormatVersion},
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Breaking: true, Message: "removed"},
+			},
+		},
+		"resource added": {
+			old: &Snapshot{FormatVersion: FormatVersion},
+			new: resource(nil, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Message: "added"},
+			},
+		},
+		"optional to computed": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Optional: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Computed: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "name", Breaking: true, Message: "changed from optional to computed"},
+			},
+		},
+		"optional to optional+computed": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Optional: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Optional: true, Computed: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "name", Message: "changed from optional to optional+computed"},
+			},
+		},
+		"required to optional": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Required: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Optional: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "name", Message: "changed from required to optional"},
+			},
+		},
+		"force new added": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Required: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Required: true, RequiresReplace: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "name", Breaking: true, Message: "now forces replacement"},
+			},
+		},
+		"force new removed": {
+			old: resource(map[string]*Attribute{"name": {Type: typeString, Required: true, RequiresReplace: true}}, nil),
+			new: resource(map[string]*Attribute{"name": {Type: typeString, Required: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "name", Message: "no longer forces replacement"},
+			},
+		},
+		"type changed": {
+			old: resource(map[string]*Attribute{"size": {Type: typeString, Optional: true}}, nil),
+			new: resource(map[string]*Attribute{"size": {Type: typeNumber, Optional: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "size", Breaking: true, Message: `type changed from "string" to "number"`},
+			},
+		},
+		"attributes added and removed": {
+			old: resource(map[string]*Attribute{"a": {Type: typeString, Optional: true}}, nil),
+			new: resource(map[string]*Attribute{"b": {Type: typeString, Optional: true}, "c": {Type: typeString, Required: true}}, nil),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "a", Breaking: true, Message: "attribute removed"},
+				{Kind: KindResource, TypeName: "aws_test", Path: "b", Message: "optional attribute added"},
+				{Kind: KindResource, TypeName: "aws_test", Path: "c", Breaking: true, Message: "required attribute added"},
+			},
+		},
+		"nested block changes": {
+			old: resource(nil, map[string]*NestedBlock{
+				"rule": {Nesting: "list", MaxItems: 5, Block: &Block{Attributes: map[string]*Attribute{"action": {Type: typeString, Optional: true}}}},
+			}),
+			new: resource(nil, map[string]*NestedBlock{
+				"rule": {Nesting: "set", MaxItems: 1, Block: &Block{Attributes: map[string]*Attribute{"action": {Type: typeString, Required: true}}}},
+			}),
+			expected: []Change{
+				{Kind: KindResource, TypeName: "aws_test", Path: "rule", Breaking: true, Message: "nesting mode changed from list to set"},
+				{Kind: KindResource, TypeName: "aws_test", Path: "rule", Breaking: true, Message: "maximum items changed from 5 to 1"},
+				{Kind: KindResource, TypeName: "aws_test", Path: "rule.action", Breaking: true, Message: "changed from optional to required"},
+			},
+		},
+		"function return type changed": {
+			old: &Snapshot{FormatVersion: FormatVersion, Functions: map[string]*Function{"arn_parse": {Return: typeString}}},
+			new: &Snapshot{FormatVersion: FormatVersion, Functions: map[string]*Function{"arn_parse": {Return: typeNumber}}},
+			expected: []Change{
+				{Kind: KindFunction, TypeName: "arn_parse", Breaking: true, Message: `return type changed from "string" to "number"`},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Compare(tc.old, tc.new)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
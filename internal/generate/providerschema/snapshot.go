@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerschema contains a canonical representation of the merged
+// (Plugin SDK V2 and Plugin Framework) provider schema that can be written to a
+// JSON snapshot and compared with a snapshot taken from a previous version.
+package providerschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// FormatVersion is the version of the snapshot file format.
+const FormatVersion = "1.0"
+
+// Snapshot is the merged provider schema.
+// Map keys are sorted when marshaled to JSON so the snapshot is canonical.
+type Snapshot struct {
+	FormatVersion      string               `json:"format_version"`
+	Provider           *Schema              `json:"provider,omitempty"`
+	Resources          map[string]*Schema   `json:"resources,omitempty"`
+	DataSources        map[string]*Schema   `json:"data_sources,omitempty"`
+	EphemeralResources map[string]*Schema   `json:"ephemeral_resources,omitempty"`
+	ListResources      map[string]*Schema   `json:"list_resources,omitempty"`
+	Actions            map[string]*Schema   `json:"actions,omitempty"`
+	Functions          map[string]*Function `json:"functions,omitempty"`
+}
+
+// Schema is the schema of a provider, resource, data source, ephemeral resource, list resource or action.
+type Schema struct {
+	Version int64  `json:"version"`
+	Block   *Block `json:"block"`
+}
+
+// Block is a schema block.
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	Blocks     map[string]*NestedBlock `json:"blocks,omitempty"`
+	Deprecated bool                    `json:"deprecated,omitempty"`
+}
+
+// Attribute is a schema attribute.
+// Type is the JSON representation of the attribute's type, e.g. `["list","string"]`.
+type Attribute struct {
+	Type            json.RawMessage `json:"type"`
+	Required        bool            `json:"required,omitempty"`
+	Optional        bool            `json:"optional,omitempty"`
+	Computed        bool            `json:"computed,omitempty"`
+	Sensitive       bool            `json:"sensitive,omitempty"`
+	WriteOnly       bool            `json:"write_only,omitempty"`
+	Deprecated      bool            `json:"deprecated,omitempty"`
+	RequiresReplace bool            `json:"requires_replace,omitempty"`
+}
+
+// NestedBlock is a schema block nested in another block.
+type NestedBlock struct {
+	Nesting         string `json:"nesting"`
+	MinItems        int64  `json:"min_items,omitempty"`
+	MaxItems        int64  `json:"max_items,omitempty"`
+	RequiresReplace bool   `json:"requires_replace,omitempty"`
+	Block           *Block `json:"block"`
+}
+
+// Function is a provider-defined function signature.
+type Function struct {
+	Parameters        []*Parameter    `json:"parameters,omitempty"`
+	VariadicParameter *Parameter      `json:"variadic_parameter,omitempty"`
+	Return            json.RawMessage `json:"return_type"`
+	Deprecated        bool            `json:"deprecated,omitempty"`
+}
+
+// Parameter is a provider-defined function parameter.
+type Parameter struct {
+	Name               string          `json:"name"`
+	Type               json.RawMessage `json:"type"`
+	AllowNullValue     bool            `json:"allow_null_value,omitempty"`
+	AllowUnknownValues bool            `json:"allow_unknown_values,omitempty"`
+}
+
+// ReadFile reads a snapshot from the specified file.
+func ReadFile(filename string) (*Snapshot, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	if snapshot.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("%s: unsupported format version %q", filename, snapshot.FormatVersion)
+	}
+
+	return &snapshot, nil
+}
+
+// WriteFile writes a snapshot to the specified file.
+func WriteFile(filename string, snapshot *Snapshot) error {
+	b, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644) //nolint:mnd // standard file permissions
+}
@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/providerschema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+)
+
+const (
+	defaultFilename = "provider_schema.json"
+)
+
+func main() {
+	var filename string
+	flag.StringVar(&filename, "out", defaultFilename, "snapshot output file")
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	g.Infof("Generating provider schema snapshot %s", filename)
+
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		g.Fatalf("creating provider: %s", err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		g.Fatalf("getting provider schema: %s", err)
+	}
+
+	for _, diag := range response.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			g.Fatalf("getting provider schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	snapshot := &providerschema.Snapshot{
+		FormatVersion:      providerschema.FormatVersion,
+		Provider:           newSchema(response.Provider),
+		Resources:          newSchemas(response.ResourceSchemas),
+		DataSources:        newSchemas(response.DataSourceSchemas),
+		EphemeralResources: newSchemas(response.EphemeralResourceSchemas),
+		ListResources:      newSchemas(response.ListResourceSchemas),
+		Actions:            make(map[string]*providerschema.Schema, len(response.ActionSchemas)),
+		Functions:          make(map[string]*providerschema.Function, len(response.Functions)),
+	}
+
+	for name, v := range response.ActionSchemas {
+		snapshot.Actions[name] = newSchema(v.Schema)
+	}
+
+	for name, v := range response.Functions {
+		snapshot.Functions[name] = newFunction(v)
+	}
+
+	// Replacement behavior is not part of the protocol schema.
+	// Plugin SDK V2 resources declare it with ForceNew and Plugin Framework resources with RequiresReplace plan modifiers.
+	for typeName, r := range primary.ResourcesMap {
+		if v, ok := snapshot.Resources[typeName]; ok {
+			setRequiresReplaceFromSDK(v.Block, r.SchemaMap())
+		}
+	}
+
+	fwProvider, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		g.Fatalf("creating Framework provider: %s", err)
+	}
+
+	for _, factory := range fwProvider.Resources(ctx) {
+		r := factory()
+
+		var metadataResponse resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+		typeName := metadataResponse.TypeName
+
+		var schemaResponse resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		if schemaResponse.Diagnostics.HasError() {
+			g.Fatalf("getting resource type (%s) schema: %v", typeName, schemaResponse.Diagnostics)
+		}
+
+		if v, ok := snapshot.Resources[typeName]; ok {
+			setRequiresReplaceFromFramework(v.Block, schemaResponse.Schema.Attributes, schemaResponse.Schema.Blocks)
+		}
+	}
+
+	if err := providerschema.WriteFile(filename, snapshot); err != nil {
+		g.Fatalf("writing %s: %s", filename, err)
+	}
+}
+
+func newSchemas(schemas map[string]*tfprotov5.Schema) map[string]*providerschema.Schema {
+	result := make(map[string]*providerschema.Schema, len(schemas))
+
+	for name, v := range schemas {
+		result[name] = newSchema(v)
+	}
+
+	return result
+}
+
+func newSchema(s *tfprotov5.Schema) *providerschema.Schema {
+	if s == nil {
+		return nil
+	}
+
+	return &providerschema.Schema{
+		Version: s.Version,
+		Block:   newBlock(s.Block),
+	}
+}
+
+func newBlock(b *tfprotov5.SchemaBlock) *providerschema.Block {
+	if b == nil {
+		return nil
+	}
+
+	block := &providerschema.Block{
+		Deprecated: b.Deprecated,
+	}
+
+	if len(b.Attributes) > 0 {
+		block.Attributes = make(map[string]*providerschema.Attribute, len(b.Attributes))
+
+		for _, v := range b.Attributes {
+			block.Attributes[v.Name] = &providerschema.Attribute{
+				Type:       typeJSON(v.Type),
+				Required:   v.Required,
+				Optional:   v.Optional,
+				Computed:   v.Computed,
+				Sensitive:  v.Sensitive,
+				WriteOnly:  v.WriteOnly,
+				Deprecated: v.Deprecated,
+			}
+		}
+	}
+
+	if len(b.BlockTypes) > 0 {
+		block.Blocks = make(map[string]*providerschema.NestedBlock, len(b.BlockTypes))
+
+		for _, v := range b.BlockTypes {
+			block.Blocks[v.TypeName] = &providerschema.NestedBlock{
+				Nesting:  strings.ToLower(v.Nesting.String()),
+				MinItems: v.MinItems,
+				MaxItems: v.MaxItems,
+				Block:    newBlock(v.Block),
+			}
+		}
+	}
+
+	return block
+}
+
+func newFunction(f *tfprotov5.Function) *providerschema.Function {
+	function := &providerschema.Function{
+		Deprecated: f.DeprecationMessage != "",
+	}
+
+	for _, v := range f.Parameters {
+		function.Parameters = append(function.Parameters, newParameter(v))
+	}
+
+	if f.VariadicParameter != nil {
+		function.VariadicParameter = newParameter(f.VariadicParameter)
+	}
+
+	if f.Return != nil {
+		function.Return = typeJSON(f.Return.Type)
+	}
+
+	return function
+}
+
+func newParameter(p *tfprotov5.FunctionParameter) *providerschema.Parameter {
+	return &providerschema.Parameter{
+		Name:               p.Name,
+		Type:               typeJSON(p.Type),
+		AllowNullValue:     p.AllowNullValue,
+		AllowUnknownValues: p.AllowUnknownValues,
+	}
+}
+
+func typeJSON(t tftypes.Type) json.RawMessage {
+	if t == nil {
+		return nil
+	}
+
+	// MarshalJSON is always error safe.
+	b, _ := t.MarshalJSON()
+
+	return b
+}
+
+func setRequiresReplaceFromSDK(block *providerschema.Block, schemaMap map[string]*schema.Schema) {
+	if block == nil {
+		return
+	}
+
+	for name, v := range schemaMap {
+		if attribute, ok := block.Attributes[name]; ok {
+			attribute.RequiresReplace = v.ForceNew
+		} else if nestedBlock, ok := block.Blocks[name]; ok {
+			nestedBlock.RequiresReplace = v.ForceNew
+
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				setRequiresReplaceFromSDK(nestedBlock.Block, elem.SchemaMap())
+			}
+		}
+	}
+}
+
+func setRequiresReplaceFromFramework(block *providerschema.Block, attributes map[string]resourceschema.Attribute, blocks map[string]resourceschema.Block) {
+	if block == nil {
+		return
+	}
+
+	for name, v := range attributes {
+		if attribute, ok := block.Attributes[name]; ok {
+			attribute.RequiresReplace = hasRequiresReplacePlanModifier(v)
+		}
+	}
+
+	for name, v := range blocks {
+		nestedBlock, ok := block.Blocks[name]
+		if !ok {
+			continue
+		}
+
+		nestedBlock.RequiresReplace = hasRequiresReplacePlanModifier(v)
+
+		switch v := v.(type) {
+		case resourceschema.ListNestedBlock:
+			setRequiresReplaceFromFramework(nestedBlock.Block, v.NestedObject.Attributes, v.NestedObject.Blocks)
+		case resourceschema.SetNestedBlock:
+			setRequiresReplaceFromFramework(nestedBlock.Block, v.NestedObject.Attributes, v.NestedObject.Blocks)
+		case resourceschema.SingleNestedBlock:
+			setRequiresReplaceFromFramework(nestedBlock.Block, v.Attributes, v.Blocks)
+		}
+	}
+}
+
+// hasRequiresReplacePlanModifier returns whether the attribute or block has a RequiresReplace (or RequiresReplaceIf) plan modifier.
+// Each attribute and block type has its own PlanModifiers field type, so reflection is used.
+func hasRequiresReplacePlanModifier(v any) bool {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return false
+	}
+
+	planModifiers := rv.FieldByName("PlanModifiers")
+	if !planModifiers.IsValid() || planModifiers.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := range planModifiers.Len() {
+		if strings.Contains(strings.ToLower(fmt.Sprintf("%T", planModifiers.Index(i).Interface())), "requiresreplace") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
@@ -212,6 +213,7 @@ type ResourceDatum struct {
 	Name                              string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
 	IsGlobal                          bool
 	regionOverrideEnabled             bool
+	AssumeRoleOverrideEnabled         bool
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
@@ -223,6 +225,8 @@ type ResourceDatum struct {
 	ImportIDHandler                   string
 	SetIDAttribute                    bool
 	HasIdentityFix                    bool
+	IsReadAfterCreateRetried          bool
+	eventualConsistencyUpdateAttrs    []string
 	common.ResourceIdentity
 }
 
@@ -238,6 +242,16 @@ func (d ResourceDatum) RegionOverrideEnabled() bool {
 	return d.regionOverrideEnabled && !d.IsGlobal
 }
 
+func (r ResourceDatum) HasEventualConsistency() bool {
+	return r.IsReadAfterCreateRetried || len(r.eventualConsistencyUpdateAttrs) > 0
+}
+
+func (r ResourceDatum) EventualConsistencyUpdateAttributes() []string {
+	return tfslices.ApplyToAll(r.eventualConsistencyUpdateAttrs, func(s string) string {
+		return namesgen.ConstOrQuote(s)
+	})
+}
+
 func (r ResourceDatum) WrappedImport() bool {
 	return r.wrappedImport == common.TriBooleanTrue
 }
@@ -276,6 +290,7 @@ type visitor struct {
 	errs []error
 	g    *common.Generator
 
+	file         *ast.File
 	fileName     string
 	functionName string
 	packageName  string
@@ -321,7 +336,11 @@ func (v *visitor) processDir(path string) {
 
 // processFile processes a single Go source file.
 func (v *visitor) processFile(file *ast.File) {
+	v.file = file
+
 	ast.Walk(v, file)
+
+	v.file = nil
 }
 
 // processFuncDecl processes a single Go function.
@@ -462,6 +481,25 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "IdentityFix":
 				d.HasIdentityFix = true
 
+			case "AssumeRoleOverride":
+				d.AssumeRoleOverrideEnabled = true
+
+			case "EventualConsistency":
+				d.IsReadAfterCreateRetried = true
+
+				if attr, ok := args.Keyword["readAfterCreate"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid EventualConsistency/readAfterCreate value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					} else {
+						d.IsReadAfterCreateRetried = b
+					}
+				}
+
+				if attr, ok := args.Keyword["updateAttributes"]; ok {
+					d.eventualConsistencyUpdateAttrs = strings.Split(attr, ";")
+				}
+
 			default:
 				if err := common.ParseResourceIdentity(annotationName, args, implementation, &d.ResourceIdentity, &d.goImports); err != nil {
 					v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
@@ -471,6 +509,15 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	// Framework resources that are identified solely by their ARN get ARN-based resource identity and import by default.
+	if annotations["FrameworkResource"] && !annotations["NoIdentity"] && !d.HasResourceIdentity() && d.wrappedImport == common.TriBooleanUnset {
+		if common.IsARNIdentifiedFrameworkResource(v.file, funcDecl) {
+			if err := common.ParseResourceIdentity("ArnIdentity", common.ParseArgs(""), common.ImplementationFramework, &d.ResourceIdentity, &d.goImports); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s.%s: %w", v.packageName, v.functionName, err))
+			}
+		}
+	}
+
 	if d.HasResourceIdentity() {
 		if d.wrappedImport == common.TriBooleanUnset {
 			d.wrappedImport = common.TriBooleanTrue
@@ -697,9 +744,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "EventualConsistency", "AssumeRoleOverride":
 				// Handled above.
-			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
+			case "ArnFormat", "IdAttrFormat", "NoIdentity", "NoImport", "Testing":
 				// Ignored.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-version"
 	acctestgen "github.com/hashicorp/terraform-provider-aws/internal/acctest/generate"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
@@ -52,6 +53,9 @@ type CommonArgs struct {
 	UseAlternateAccount     bool
 	AlternateRegionProvider bool
 
+	// Plugin SDK V2 to Plugin Framework migration
+	MigratedFromPluginSDKVersion *version.Version
+
 	Generator     string
 	generatorSeen bool
 
@@ -333,6 +337,15 @@ func ParseTestingAnnotations(args common.Args, stuff *CommonArgs) error {
 		}
 	}
 
+	// Migration
+	if attr, ok := args.Keyword["migratedFromPluginSDKVersion"]; ok {
+		if v, err := version.NewVersion(attr); err != nil {
+			return fmt.Errorf("invalid migratedFromPluginSDKVersion value: %q. Should be version value.", attr)
+		} else {
+			stuff.MigratedFromPluginSDKVersion = v
+		}
+	}
+
 	// TF Variables
 	if attr, ok := args.Keyword["generator"]; ok {
 		stuff.generatorSeen = true
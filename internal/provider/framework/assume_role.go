@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func validateInContextAssumeRoleARN(ctx context.Context, c awsClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := c.ValidateInContextAssumeRoleARN(ctx); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrAssumeRoleARN), "Invalid Assume Role ARN Value", err.Error())
+	}
+
+	return diags
+}
+
+type dataSourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r dataSourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = dsschema.StringAttribute{
+				Optional:    true,
+				Description: names.DataSourceTopLevelAssumeRoleARNAttributeDescription,
+			}
+		}
+	}
+}
+
+// dataSourceInjectAssumeRoleAttribute injects a top-level "assume_role_arn" attribute into a data source's schema.
+func dataSourceInjectAssumeRoleAttribute() dataSourceSchemaInterceptor {
+	return &dataSourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type dataSourceValidateAssumeRoleInterceptor struct{}
+
+func (r dataSourceValidateAssumeRoleInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		// As data sources have no ModifyPlan functionality we validate the per-resource assume role override value before R.
+		opts.response.Diagnostics.Append(validateInContextAssumeRoleARN(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// dataSourceValidateAssumeRole validates the value of the top-level `assume_role_arn` attribute.
+func dataSourceValidateAssumeRole() dataSourceCRUDInterceptor {
+	return &dataSourceValidateAssumeRoleInterceptor{}
+}
+
+type resourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrAssumeRoleARN]; !ok {
+			// Inject a top-level "assume_role_arn" attribute.
+			response.Schema.Attributes[names.AttrAssumeRoleARN] = resourceattribute.AssumeRoleARN()
+		}
+	}
+}
+
+// resourceInjectAssumeRoleAttribute injects a top-level "assume_role_arn" attribute into a resource's schema.
+func resourceInjectAssumeRoleAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type resourceValidateAssumeRoleInterceptor struct{}
+
+func (r resourceValidateAssumeRoleInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch when := opts.when; when {
+	case Before:
+		opts.response.Diagnostics.Append(validateInContextAssumeRoleARN(ctx, c)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// resourceValidateAssumeRole validates the value of the top-level `assume_role_arn` attribute.
+func resourceValidateAssumeRole() resourceModifyPlanInterceptor {
+	return &resourceValidateAssumeRoleInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleAccountChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleAccountChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		var planRoleARN types.String
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &planRoleARN)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		var stateRoleARN types.String
+		opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &stateRoleARN)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		// An unknown value may change the resource's account.
+		if planRoleARN.IsUnknown() {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrAssumeRoleARN))
+			return
+		}
+
+		// Changing to another role in the same account is an in-place update.
+		providerAccountID := c.AccountID(conns.WithOverrideAssumeRoleARN(ctx, ""))
+		if assumeRoleAccountID(planRoleARN.ValueString(), providerAccountID) != assumeRoleAccountID(stateRoleARN.ValueString(), providerAccountID) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrAssumeRoleARN))
+		}
+	}
+}
+
+// resourceForceNewIfAssumeRoleAccountChanges forces resource replacement if the value of the top-level `assume_role_arn` attribute
+// changes the resource's account.
+func resourceForceNewIfAssumeRoleAccountChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+}
+
+// assumeRoleAccountID returns the AWS account ID for a per-resource assume role override value.
+// No override indicates the provider's configured account.
+func assumeRoleAccountID(roleARN, providerAccountID string) string {
+	if roleARN == "" {
+		return providerAccountID
+	}
+
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return roleARN
+	}
+
+	return v.AccountID
+}
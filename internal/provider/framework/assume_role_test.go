@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceForceNewIfAssumeRoleAccountChangesInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerAccountID = "111111111111"
+		spokeRoleARN      = "arn:aws:iam::123456789012:role/spoke"       //lintignore:AWSAT005
+		otherSpokeRoleARN = "arn:aws:iam::123456789012:role/other-spoke" //lintignore:AWSAT005
+		hubRoleARN        = "arn:aws:iam::111111111111:role/hub"         //lintignore:AWSAT005
+	)
+
+	ctx := t.Context()
+	client := mockClient{accountID: providerAccountID}
+	icpt := resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName:          schema.StringAttribute{Required: true},
+			names.AttrAssumeRoleARN: resourceattribute.AssumeRoleARN(),
+		},
+	}
+
+	tests := map[string]struct {
+		stateRoleARN   string
+		planRoleARN    string
+		expectReplaced bool
+	}{
+		"no change": {
+			stateRoleARN: spokeRoleARN,
+			planRoleARN:  spokeRoleARN,
+		},
+		"role in same account": {
+			stateRoleARN: spokeRoleARN,
+			planRoleARN:  otherSpokeRoleARN,
+		},
+		"role in other account": {
+			planRoleARN:    spokeRoleARN,
+			expectReplaced: true,
+		},
+		"role removed": {
+			stateRoleARN:   spokeRoleARN,
+			expectReplaced: true,
+		},
+		"role in provider account": {
+			planRoleARN: hubRoleARN,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			stateAttrs := map[string]string{names.AttrName: "example"}
+			if tc.stateRoleARN != "" {
+				stateAttrs[names.AttrAssumeRoleARN] = tc.stateRoleARN
+			}
+			planAttrs := map[string]string{names.AttrName: "example"}
+			if tc.planRoleARN != "" {
+				planAttrs[names.AttrAssumeRoleARN] = tc.planRoleARN
+			}
+
+			req := resource.ModifyPlanRequest{
+				State: stateFromSchema(ctx, s, stateAttrs),
+				Plan:  planFromSchema(ctx, s, planAttrs),
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := len(resp.RequiresReplace) > 0, tc.expectReplaced; got != want {
+				t.Errorf("expected replacement %t, got %t", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// defaultEventualConsistencyTimeout is used for resources that don't define a Create timeout.
+// It matches the Plugin SDKv2 default.
+const defaultEventualConsistencyTimeout = 20 * time.Minute
+
+type readFunc func(context.Context, resource.ReadRequest, *resource.ReadResponse)
+
+type createTimeouter interface {
+	CreateTimeout(context.Context, timeouts.Value) time.Duration
+}
+
+var _ resourceCRUDInterceptor = &eventualConsistencyInterceptor{}
+
+// eventualConsistencyInterceptor reads a resource after it is created (or updated) and retries
+// while the resource is not found, using the resource's Create timeout.
+// The resource's Read method signals "not found" by removing the resource from state (response.State.RemoveResource).
+type eventualConsistencyInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName                 string
+	readResource             readFunc
+	createTimeout            func(context.Context, timeouts.Value) time.Duration
+	isReadAfterCreateRetried bool
+	updateAttributes         []string
+}
+
+func (r *eventualConsistencyInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	if !r.isReadAfterCreateRetried {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		if response.State.Raw.IsNull() {
+			break
+		}
+
+		readResponse := r.readWithRetry(ctx, resource.ReadRequest{
+			State:        response.State,
+			Private:      response.Private,
+			Identity:     response.Identity,
+			ProviderMeta: request.ProviderMeta,
+		})
+		response.Diagnostics.Append(readResponse.Diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.State = readResponse.State
+		response.Private = readResponse.Private
+		response.Identity = readResponse.Identity
+	}
+}
+
+func (r *eventualConsistencyInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	if len(r.updateAttributes) == 0 {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		if response.State.Raw.IsNull() {
+			break
+		}
+
+		if changed, diags := hasChanges(ctx, request.Plan, request.State, r.updateAttributes); diags.HasError() {
+			response.Diagnostics.Append(diags...)
+			return
+		} else if !changed {
+			break
+		}
+
+		readResponse := r.readWithRetry(ctx, resource.ReadRequest{
+			State:        response.State,
+			Private:      response.Private,
+			Identity:     response.Identity,
+			ProviderMeta: request.ProviderMeta,
+		})
+		response.Diagnostics.Append(readResponse.Diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.State = readResponse.State
+		response.Private = readResponse.Private
+		response.Identity = readResponse.Identity
+	}
+}
+
+// readWithRetry calls the resource's Read method, retrying while the resource is not found.
+func (r *eventualConsistencyInterceptor) readWithRetry(ctx context.Context, request resource.ReadRequest) *resource.ReadResponse {
+	var response resource.ReadResponse
+
+	_, err := tfresource.RetryWhenNotFound(ctx, r.timeout(ctx, request.State), func(ctx context.Context) (any, error) {
+		response = resource.ReadResponse{
+			State:    request.State,
+			Private:  request.Private,
+			Identity: request.Identity,
+		}
+
+		r.readResource(ctx, request, &response)
+		if !response.Diagnostics.HasError() && response.State.Raw.IsNull() {
+			return nil, &retry.NotFoundError{}
+		}
+
+		return nil, nil
+	})
+
+	if err != nil {
+		// Discard any "not found" warnings.
+		response.Diagnostics = nil
+		response.Diagnostics.AddError(fmt.Sprintf("reading %s", r.typeName), err.Error())
+	}
+
+	return &response
+}
+
+// timeout returns the resource's configured (or default) Create timeout.
+func (r *eventualConsistencyInterceptor) timeout(ctx context.Context, state tfsdk.State) time.Duration {
+	timeout := defaultEventualConsistencyTimeout
+
+	if r.createTimeout != nil {
+		var v timeouts.Value
+		// A resource without a "timeouts" attribute uses its default Create timeout.
+		if diags := state.GetAttribute(ctx, path.Root(names.AttrTimeouts), &v); diags.HasError() {
+			v = timeouts.Value{}
+		}
+		if t := r.createTimeout(ctx, v); t > 0 {
+			timeout = t
+		}
+	}
+
+	return timeout
+}
+
+// hasChanges returns whether any of the specified top-level attributes differ between plan and state.
+func hasChanges(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, attributeNames []string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, attributeName := range attributeNames {
+		var planValue, stateValue attr.Value
+		diags.Append(plan.GetAttribute(ctx, path.Root(attributeName), &planValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+		diags.Append(state.GetAttribute(ctx, path.Root(attributeName), &stateValue)...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if !planValue.Equal(stateValue) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+// newEventualConsistencyInterceptor returns an interceptor that retries the first Read after Create
+// (and after Update if any of the specified attributes change) while the resource is not found.
+// The interceptor's readResource function must be set before use.
+func newEventualConsistencyInterceptor(typeName string, inner resource.Resource, spec inttypes.ServicePackageResourceEventualConsistency) *eventualConsistencyInterceptor {
+	interceptor := &eventualConsistencyInterceptor{
+		typeName:                 typeName,
+		isReadAfterCreateRetried: spec.IsReadAfterCreateRetried,
+		updateAttributes:         spec.UpdateAttributes,
+	}
+
+	if v, ok := inner.(createTimeouter); ok {
+		interceptor.createTimeout = v.CreateTimeout
+	}
+
+	return interceptor
+}
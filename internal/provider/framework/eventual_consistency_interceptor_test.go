@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestEventualConsistencyInterceptor_Create(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		notFoundCount int
+		expectError   bool
+		expectStatus  string
+	}{
+		"found": {
+			expectStatus: "ACTIVE",
+		},
+		"found after retries": {
+			notFoundCount: 2,
+			expectStatus:  "ACTIVE",
+		},
+		"never found": {
+			notFoundCount: 1000,
+			expectError:   true,
+		},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			inner := &mockEventuallyConsistentResource{
+				notFoundCount: tc.notFoundCount,
+				createTimeout: 2 * time.Second,
+			}
+			interceptor := newEventualConsistencyInterceptor("aws_test", inner, inttypes.ServicePackageResourceEventualConsistency{
+				IsReadAfterCreateRetried: true,
+			})
+			interceptor.readResource = inner.Read
+
+			stateAttrs := map[string]string{
+				"name": "a_name",
+			}
+			request := resource.CreateRequest{
+				Plan: planFromSchema(ctx, resourceSchema, stateAttrs),
+			}
+			response := resource.CreateResponse{
+				State: stateFromSchema(ctx, resourceSchema, stateAttrs),
+			}
+			opts := interceptorOptions[resource.CreateRequest, resource.CreateResponse]{
+				c:        mockClient{},
+				request:  &request,
+				response: &response,
+				when:     After,
+			}
+
+			interceptor.create(ctx, opts)
+
+			if got, want := response.Diagnostics.HasError(), tc.expectError; got != want {
+				t.Fatalf("expected error %t, got %t: %s", want, got, response.Diagnostics)
+			}
+			if tc.expectError {
+				return
+			}
+			if got, want := inner.readCount, tc.notFoundCount+1; got != want {
+				t.Errorf("expected %d Read calls, got %d", want, got)
+			}
+
+			var status types.String
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("status"), &status)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+			if got, want := status.ValueString(), tc.expectStatus; got != want {
+				t.Errorf("expected status %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestEventualConsistencyInterceptor_Update(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		planAttrs       map[string]string
+		expectReadCount int
+	}{
+		"retried attribute changed": {
+			planAttrs: map[string]string{
+				"name": "new_name",
+			},
+			expectReadCount: 2,
+		},
+		"other attribute changed": {
+			planAttrs: map[string]string{
+				"name":        "a_name",
+				"description": "new description",
+			},
+			expectReadCount: 0,
+		},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			inner := &mockEventuallyConsistentResource{
+				notFoundCount: 1,
+				createTimeout: 2 * time.Second,
+			}
+			interceptor := newEventualConsistencyInterceptor("aws_test", inner, inttypes.ServicePackageResourceEventualConsistency{
+				UpdateAttributes: []string{"name"},
+			})
+			interceptor.readResource = inner.Read
+
+			request := resource.UpdateRequest{
+				Plan: planFromSchema(ctx, resourceSchema, tc.planAttrs),
+				State: stateFromSchema(ctx, resourceSchema, map[string]string{
+					"name": "a_name",
+				}),
+			}
+			response := resource.UpdateResponse{
+				State: stateFromSchema(ctx, resourceSchema, tc.planAttrs),
+			}
+			opts := interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]{
+				c:        mockClient{},
+				request:  &request,
+				response: &response,
+				when:     After,
+			}
+
+			interceptor.update(ctx, opts)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+			if got, want := inner.readCount, tc.expectReadCount; got != want {
+				t.Errorf("expected %d Read calls, got %d", want, got)
+			}
+		})
+	}
+}
+
+// mockEventuallyConsistentResource is a resource that is not found for a number of Reads.
+type mockEventuallyConsistentResource struct {
+	resource.Resource
+	notFoundCount int
+	readCount     int
+	createTimeout time.Duration
+}
+
+func (r *mockEventuallyConsistentResource) CreateTimeout(context.Context, timeouts.Value) time.Duration {
+	return r.createTimeout
+}
+
+func (r *mockEventuallyConsistentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	r.readCount++
+
+	if r.readCount <= r.notFoundCount {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("status"), "ACTIVE")...)
+}
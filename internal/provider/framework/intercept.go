@@ -26,9 +26,12 @@ type awsClient interface {
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	PreventDestroyConfig(ctx context.Context) *tftags.PreventDestroyConfig
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
+	ValidateInContextAssumeRoleARN(ctx context.Context) error
 	ValidateInContextRegionInPartition(ctx context.Context) error
+	WarnOnUnknownReplacements(ctx context.Context) bool
 	AwsConfig(context.Context) aws.Config
 }
 
@@ -145,6 +145,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"prevent_destroy_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Resource tags that protect resources from deletion. Deleting or replacing a resource whose tags include any of these tags, with a matching value, fails.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -230,6 +235,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Product details to append to the User-Agent string sent in all AWS API calls.",
 			},
+			"warn_on_unknown_replacements": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Warn when a resource is planned for replacement because an argument that forces replacement depends on a value that is not known until apply.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -507,6 +516,11 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 				continue
 			}
 
+			if err := validateSchemaAssumeRoleForDataSource(dataSourceSpec.AssumeRole, schemaResponse.Schema); err != nil {
+				errs = append(errs, fmt.Errorf("data source type %q: %w", typeName, err))
+				continue
+			}
+
 			if err := validateSchemaTagsForDataSource(dataSourceSpec.Tags, schemaResponse.Schema); err != nil {
 				errs = append(errs, fmt.Errorf("data source type %q: %w", typeName, err))
 				continue
@@ -570,6 +584,11 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 				continue
 			}
 
+			if err := validateSchemaAssumeRoleForResource(resourceSpec.AssumeRole, schemaResponse.Schema); err != nil {
+				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
+				continue
+			}
+
 			if err := validateSchemaTagsForResource(resourceSpec.Tags, schemaResponse.Schema); err != nil {
 				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
 				continue
@@ -583,7 +602,8 @@ func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
 					}
 				}
 
-				if _, ok := inner.(framework.ImportByIdentityer); !ok {
+				// ARN-identified resources can use the generic ARN importer.
+				if _, ok := inner.(framework.ImportByIdentityer); !ok && !resourceSpec.Identity.IsARN {
 					errs = append(errs, fmt.Errorf("resource type %q: cannot configure importer, does not implement %q", resourceSpec.TypeName, reflect.TypeFor[framework.ImportByIdentityer]()))
 					continue
 				}
@@ -603,6 +623,15 @@ func validateSchemaRegionForDataSource(regionSpec unique.Handle[inttypes.Service
 	return nil
 }
 
+func validateSchemaAssumeRoleForDataSource(assumeRoleSpec inttypes.ServicePackageResourceAssumeRole, schema datasourceschema.Schema) error {
+	if assumeRoleSpec.IsOverrideEnabled {
+		if _, ok := schema.Attributes[names.AttrAssumeRoleARN]; ok {
+			return fmt.Errorf("configured for per-resource assume role but defines `%s` attribute in schema", names.AttrAssumeRoleARN)
+		}
+	}
+	return nil
+}
+
 func validateSchemaRegionForEphemeralResource(regionSpec unique.Handle[inttypes.ServicePackageResourceRegion], schema empemeralschema.Schema) error {
 	if !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		if _, ok := schema.Attributes[names.AttrRegion]; ok {
@@ -632,6 +661,15 @@ func validateSchemaRegionForResource(regionSpec unique.Handle[inttypes.ServicePa
 	return nil
 }
 
+func validateSchemaAssumeRoleForResource(assumeRoleSpec inttypes.ServicePackageResourceAssumeRole, schema resourceschema.Schema) error {
+	if assumeRoleSpec.IsOverrideEnabled {
+		if _, ok := schema.Attributes[names.AttrAssumeRoleARN]; ok {
+			return fmt.Errorf("configured for per-resource assume role but defines `%s` attribute in schema", names.AttrAssumeRoleARN)
+		}
+	}
+	return nil
+}
+
 func validateSchemaTagsForDataSource(tagsSpec unique.Handle[inttypes.ServicePackageResourceTags], schema datasourceschema.Schema) error {
 	if !tfunique.IsHandleNil(tagsSpec) {
 		if v, ok := schema.Attributes[names.AttrTags]; ok {
@@ -17,3 +17,10 @@ var Region = sync.OnceValue(func() schema.Attribute {
 		Description: names.ResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var AssumeRoleARN = sync.OnceValue(func() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
@@ -333,3 +333,46 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 		}
 	}
 }
+
+// resourcePreventDestroy prevents the deletion of resources whose last-known tags match any provider configured prevent_destroy_tags.
+// Resource replacement also deletes the existing resource, so is prevented too.
+func resourcePreventDestroy() resourceCRUDInterceptor {
+	return &resourcePreventDestroyInterceptor{}
+}
+
+type resourcePreventDestroyInterceptor struct {
+	resourceNoOpCRUDInterceptor
+}
+
+func (r resourcePreventDestroyInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	c := opts.c
+
+	config := c.PreventDestroyConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	_, serviceName, resourceName, _, _, ok := interceptors.InfoFromContext(ctx, c)
+	if !ok {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		var stateTagsAll tftags.Map
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if matches := config.Matches(tftags.New(ctx, stateTagsAll)); len(matches) > 0 {
+			keys := matches.Keys()
+			slices.Sort(keys)
+
+			response.Diagnostics.AddError(
+				fmt.Sprintf("deleting %s %s", serviceName, resourceName),
+				fmt.Sprintf("Deletion is prevented by the provider's prevent_destroy_tags configuration, matching tags: %s", keys),
+			)
+		}
+	}
+}
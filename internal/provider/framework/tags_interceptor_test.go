@@ -409,3 +409,100 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+type mockPreventDestroyClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockPreventDestroyClient) PreventDestroyConfig(ctx context.Context) *tftags.PreventDestroyConfig {
+	return &tftags.PreventDestroyConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"protected": "true",
+		}),
+	}
+}
+
+func Test_resourcePreventDestroyInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		if v, ok := meta.(awsClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
+		}
+
+		return ctx
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+	}
+	rawVal := func(tagsAll map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, "test"),
+			"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tagsAll),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		state     tftypes.Value
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name:  "no tags",
+			state: rawVal(nil),
+		},
+		{
+			name: "matching tag",
+			state: rawVal(map[string]tftypes.Value{
+				"protected": tftypes.NewValue(tftypes.String, "true"),
+				"other":     tftypes.NewValue(tftypes.String, "value"),
+			}),
+			wantDiags: diag.Diagnostics{diag.NewErrorDiagnostic(
+				"deleting <service> test",
+				"Deletion is prevented by the provider's prevent_destroy_tags configuration, matching tags: [protected]",
+			),
+			},
+		},
+		{
+			name: "matching key different value",
+			state: rawVal(map[string]tftypes.Value{
+				"protected": tftypes.NewValue(tftypes.String, "false"),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]{
+				c: mockPreventDestroyClient{},
+				request: &resource.DeleteRequest{
+					State: tfsdk.State{
+						Raw:    tt.state,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.DeleteResponse{},
+				when:     Before,
+			}
+
+			r := resourcePreventDestroy()
+			r.delete(bootstrapContext(ctx, opts.c), opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+)
+
+type resourceWarnOnUnknownRequiresReplaceInterceptor struct{}
+
+func (r resourceWarnOnUnknownRequiresReplaceInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		// Attribute plan modifiers and the resource's ModifyPlan method have now set all the paths that require replacement.
+		if !c.WarnOnUnknownReplacements(ctx) {
+			return
+		}
+
+		fwplanmodifiers.WarnOnUnknownRequiresReplace(ctx, *request, response)
+	}
+}
+
+// resourceWarnOnUnknownRequiresReplace warns of replacements caused by attributes whose values are unknown at plan time.
+// Warnings are only emitted if enabled in the provider configuration.
+func resourceWarnOnUnknownRequiresReplace() resourceModifyPlanInterceptor {
+	return &resourceWarnOnUnknownRequiresReplaceInterceptor{}
+}
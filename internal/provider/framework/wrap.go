@@ -62,6 +62,11 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 		interceptors = append(interceptors, dataSourceSetRegionInState())
 	}
 
+	if spec.AssumeRole.IsOverrideEnabled {
+		interceptors = append(interceptors, dataSourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, dataSourceValidateAssumeRole())
+	}
+
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, dataSourceTransparentTagging(spec.Tags))
 	}
@@ -97,6 +102,17 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if w.spec.AssumeRole.IsOverrideEnabled && getAttribute != nil {
+		var target types.String
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if roleARN := target.ValueString(); roleARN != "" {
+			ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+		}
+	}
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -539,8 +555,20 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		isRegionOverrideEnabled = true
 	}
 
+	inner, _ := spec.Factory(context.TODO())
+
 	var interceptors interceptorInvocations
 
+	var eventualConsistency *eventualConsistencyInterceptor
+	if v := spec.EventualConsistency; v.IsEnabled() {
+		// After interceptors are run last to first, so this interceptor's Read sees the results of all others.
+		eventualConsistency = newEventualConsistencyInterceptor(spec.TypeName, inner, v)
+		interceptors = append(interceptors, eventualConsistency)
+	}
+
+	// After interceptors are run last to first, so this interceptor sees all paths requiring replacement.
+	interceptors = append(interceptors, resourceWarnOnUnknownRequiresReplace())
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -558,20 +586,30 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		}
 	}
 
+	if spec.AssumeRole.IsOverrideEnabled {
+		interceptors = append(interceptors, resourceInjectAssumeRoleAttribute())
+		interceptors = append(interceptors, resourceValidateAssumeRole())
+		interceptors = append(interceptors, resourceForceNewIfAssumeRoleAccountChanges())
+	}
+
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
+		interceptors = append(interceptors, resourcePreventDestroy())
 	}
 
-	inner, _ := spec.Factory(context.TODO())
-
 	if len(spec.Identity.Attributes) == 0 {
-		return &wrappedResource{
+		w := &wrappedResource{
 			inner:              inner,
 			servicePackageName: servicePackageName,
 			spec:               spec,
 			interceptors:       interceptors,
 		}
+		if eventualConsistency != nil {
+			eventualConsistency.readResource = w.Read
+		}
+
+		return w
 	}
 
 	interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
@@ -587,7 +625,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		// it will be caught by `validateResourceSchemas`, so we can ignore it here.
 	}
 
-	return &wrappedResourceWithIdentity{
+	w := &wrappedResourceWithIdentity{
 		wrappedResource: wrappedResource{
 			inner:              inner,
 			servicePackageName: servicePackageName,
@@ -595,6 +633,11 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 			interceptors:       interceptors,
 		},
 	}
+	if eventualConsistency != nil {
+		eventualConsistency.readResource = w.Read
+	}
+
+	return w
 }
 
 // context is run on all wrapped methods before any interceptors.
@@ -618,6 +661,17 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	if w.spec.AssumeRole.IsOverrideEnabled && getAttribute != nil {
+		var target types.String
+		diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRoleARN), &target)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if roleARN := target.ValueString(); roleARN != "" {
+			ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN)
+		}
+	}
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -724,7 +778,18 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var f func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		f = v.ImportState
+	}
+	// ARN-identified resources that don't implement import by identity use the generic ARN importer.
+	if w.spec.Import.WrappedImport && w.spec.Identity.IsARN {
+		if _, ok := w.inner.(framework.ImportByIdentityer); !ok {
+			f = w.importStateByARN
+		}
+	}
+
+	if f != nil {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -732,7 +797,7 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 		}
 
 		ctx = importer.Context(ctx, w.meta)
-		interceptedHandler(w.interceptors.resourceImportState(), v.ImportState, resourceImportStateHasError, w.meta)(ctx, request, response)
+		interceptedHandler(w.interceptors.resourceImportState(), f, resourceImportStateHasError, w.meta)(ctx, request, response)
 
 		return
 	}
@@ -743,6 +808,22 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+func (w *wrappedResource) importStateByARN(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	client := importer.Client(ctx)
+	if client == nil {
+		response.Diagnostics.AddError(
+			"Unexpected Error",
+			"An unexpected error occurred while importing a resource. "+
+				"This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Importer context was nil.",
+		)
+		return
+	}
+
+	importer.ARN(ctx, client, request, &w.spec.Identity, &w.spec.Import, response)
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	ctx, diags := w.context(ctx, request.Config.GetAttribute, &request.ProviderMeta, w.meta)
 	response.Diagnostics.Append(diags...)
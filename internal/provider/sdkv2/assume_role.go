@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceValidateAssumeRole() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				return c.ValidateInContextAssumeRoleARN(ctx)
+			}
+		}
+
+		return nil
+	})
+}
+
+func dataSourceValidateAssumeRole() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Read:
+				// As data sources have no CustomizeDiff functionality, we validate the per-resource assume role override value here.
+				if err := c.ValidateInContextAssumeRoleARN(ctx); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+func forceNewIfAssumeRoleAccountChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Force resource replacement if the value of the top-level `assume_role_arn` attribute changes the resource's account.
+				// Changing to another role in the same account is an in-place update.
+				if d.Id() != "" && d.HasChange(names.AttrAssumeRoleARN) {
+					providerAccountID := c.AccountID(conns.WithOverrideAssumeRoleARN(ctx, ""))
+					o, n := d.GetChange(names.AttrAssumeRoleARN)
+					if assumeRoleAccountID(o.(string), providerAccountID) != assumeRoleAccountID(n.(string), providerAccountID) {
+						return d.ForceNew(names.AttrAssumeRoleARN)
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// assumeRoleAccountID returns the AWS account ID for a per-resource assume role override value.
+// No override indicates the provider's configured account.
+func assumeRoleAccountID(roleARN, providerAccountID string) string {
+	if roleARN == "" {
+		return providerAccountID
+	}
+
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return roleARN
+	}
+
+	return v.AccountID
+}
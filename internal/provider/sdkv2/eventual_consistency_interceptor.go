@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ crudInterceptor = eventualConsistencyInterceptor{}
+
+// eventualConsistencyInterceptor reads a resource after it is created (or updated) and retries
+// while the resource is not found, using the resource's Create timeout.
+// The resource's Read handler signals "not found" by removing the resource from state (d.SetId("")).
+type eventualConsistencyInterceptor struct {
+	typeName         string
+	read             func() schema.ReadContextFunc
+	updateAttributes []string
+}
+
+func (r eventualConsistencyInterceptor) run(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		rd, ok := d.(*schema.ResourceData)
+		if !ok {
+			break
+		}
+
+		if why == Update && (len(r.updateAttributes) == 0 || !rd.HasChanges(r.updateAttributes...)) {
+			break
+		}
+
+		id := rd.Id()
+		if id == "" {
+			break
+		}
+
+		read := r.read()
+		if read == nil {
+			break
+		}
+
+		var readDiags diag.Diagnostics
+		_, err := tfresource.RetryWhenNotFound(ctx, rd.Timeout(schema.TimeoutCreate), func(ctx context.Context) (any, error) {
+			rd.SetId(id)
+
+			readDiags = read(ctx, rd, opts.c)
+			if !readDiags.HasError() && rd.Id() == "" {
+				return nil, &retry.NotFoundError{}
+			}
+
+			return nil, nil
+		})
+
+		if err != nil {
+			// Keep the resource in state so that it is tainted rather than orphaned.
+			rd.SetId(id)
+
+			return sdkdiag.AppendErrorf(diags, "reading %s (%s): %s", r.typeName, id, err)
+		}
+
+		diags = append(diags, readDiags...)
+	}
+
+	return diags
+}
+
+// newEventualConsistencyInterceptor returns an interceptor that retries the first Read after Create
+// (and after Update if any of the specified attributes change) while the resource is not found.
+// `read` returns the resource's (wrapped) Read handler.
+func newEventualConsistencyInterceptor(typeName string, read func() schema.ReadContextFunc, spec inttypes.ServicePackageResourceEventualConsistency) interceptorInvocation {
+	var v why
+	if spec.IsReadAfterCreateRetried {
+		v |= Create
+	}
+	if len(spec.UpdateAttributes) > 0 {
+		v |= Update
+	}
+
+	return interceptorInvocation{
+		when: After,
+		why:  v,
+		interceptor: eventualConsistencyInterceptor{
+			typeName:         typeName,
+			read:             read,
+			updateAttributes: spec.UpdateAttributes,
+		},
+	}
+}
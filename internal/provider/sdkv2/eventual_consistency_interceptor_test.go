@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEventualConsistencyInterceptor_Create(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		notFoundCount int
+		expectError   bool
+	}{
+		"found": {},
+		"found after retries": {
+			notFoundCount: 2,
+		},
+		"never found": {
+			notFoundCount: 1000,
+			expectError:   true,
+		},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			read := mockEventuallyConsistentRead{
+				notFoundCount: tc.notFoundCount,
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					names.AttrStatus: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(2 * time.Second),
+				},
+			}
+
+			invocation := newEventualConsistencyInterceptor("aws_test", func() schema.ReadContextFunc { return read.read }, inttypes.ServicePackageResourceEventualConsistency{
+				IsReadAfterCreateRetried: true,
+			})
+			interceptor := invocation.interceptor.(eventualConsistencyInterceptor)
+
+			d := r.Data(nil)
+			d.SetId("some_id")
+			d.Set("name", "a_name")
+
+			opts := crudInterceptorOptions{
+				c:    mockClient{},
+				d:    d,
+				when: After,
+				why:  Create,
+			}
+
+			diags := interceptor.run(ctx, opts)
+
+			if got, want := diags.HasError(), tc.expectError; got != want {
+				t.Fatalf("expected error %t, got %t: %v", want, got, diags)
+			}
+			if got, want := d.Id(), "some_id"; got != want {
+				t.Errorf("expected ID %q, got %q", want, got)
+			}
+			if tc.expectError {
+				return
+			}
+			if got, want := read.count, tc.notFoundCount+1; got != want {
+				t.Errorf("expected %d Read calls, got %d", want, got)
+			}
+			if got, want := d.Get(names.AttrStatus).(string), "ACTIVE"; got != want {
+				t.Errorf("expected status %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestEventualConsistencyInterceptor_Update(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrDescription: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrStatus: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	testCases := map[string]struct {
+		config          map[string]any
+		expectReadCount int
+	}{
+		"retried attribute changed": {
+			config: map[string]any{
+				"name": "new_name",
+			},
+			expectReadCount: 2,
+		},
+		"other attribute changed": {
+			config: map[string]any{
+				names.AttrDescription: "new description",
+			},
+			expectReadCount: 0,
+		},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			read := mockEventuallyConsistentRead{
+				notFoundCount: 1,
+			}
+
+			invocation := newEventualConsistencyInterceptor("aws_test", func() schema.ReadContextFunc { return read.read }, inttypes.ServicePackageResourceEventualConsistency{
+				UpdateAttributes: []string{"name"},
+			})
+			interceptor := invocation.interceptor.(eventualConsistencyInterceptor)
+
+			if got, want := invocation.why, Update; got != want {
+				t.Errorf("expected invocation for %d, got %d", want, got)
+			}
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, tc.config)
+			d.SetId("some_id")
+
+			opts := crudInterceptorOptions{
+				c:    mockClient{},
+				d:    d,
+				when: After,
+				why:  Update,
+			}
+
+			diags := interceptor.run(ctx, opts)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := read.count, tc.expectReadCount; got != want {
+				t.Errorf("expected %d Read calls, got %d", want, got)
+			}
+		})
+	}
+}
+
+// mockEventuallyConsistentRead is a Read handler for a resource that is not found for a number of Reads.
+type mockEventuallyConsistentRead struct {
+	notFoundCount int
+	count         int
+}
+
+func (m *mockEventuallyConsistentRead) read(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.count++
+
+	if m.count <= m.notFoundCount {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set(names.AttrStatus, "ACTIVE")
+
+	return diags
+}
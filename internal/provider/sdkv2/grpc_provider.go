@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// NewGRPCProviderServer returns a function that returns the Plugin SDK V2 provider's protocol version 5 server.
+// The server extends the Plugin SDK V2 server with functionality that resources can't implement themselves.
+func NewGRPCProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &grpcProviderServer{
+			ProviderServer: p.GRPCProvider(),
+			provider:       p,
+		}
+	}
+}
+
+type grpcProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s *grpcProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	private, err := decodePrivateState(request.PriorPrivate)
+	if err != nil {
+		return &tfprotov5.PlanResourceChangeResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{privateStateErrorDiagnostic(err)},
+		}, nil
+	}
+
+	ctx = sdkv2.ContextWithPrivateState(ctx, private)
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	// Plugin SDK V2 builds the planned private state from the diff, which doesn't include the prior private state.
+	if response.PlannedPrivate, err = encodePrivateState(response.PlannedPrivate, private); err != nil {
+		response.Diagnostics = append(response.Diagnostics, privateStateErrorDiagnostic(err))
+	}
+
+	// As Plugin SDK V2 CustomizeDiff functions can't return warning diagnostics, the warnings are added to the response.
+	if len(response.RequiresReplace) > 0 && s.warnOnUnknownReplacements(ctx) {
+		if r, ok := s.provider.ResourcesMap[request.TypeName]; ok {
+			response.Diagnostics = append(response.Diagnostics, unknownForceNewDiagnostics(r, request, response.RequiresReplace)...)
+		}
+	}
+
+	return response, err
+}
+
+func (s *grpcProviderServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	private, err := decodePrivateState(request.PlannedPrivate)
+	if err != nil {
+		return &tfprotov5.ApplyResourceChangeResponse{
+			Diagnostics: []*tfprotov5.Diagnostic{privateStateErrorDiagnostic(err)},
+		}, nil
+	}
+
+	ctx = sdkv2.ContextWithPrivateState(ctx, private)
+	response, err := s.ProviderServer.ApplyResourceChange(ctx, request)
+
+	if err != nil || response == nil || len(response.Private) == 0 {
+		return response, err
+	}
+
+	if response.Private, err = encodePrivateState(response.Private, private); err != nil {
+		response.Diagnostics = append(response.Diagnostics, privateStateErrorDiagnostic(err))
+	}
+
+	return response, err
+}
+
+func (s *grpcProviderServer) warnOnUnknownReplacements(ctx context.Context) bool {
+	c, ok := s.provider.Meta().(interface {
+		WarnOnUnknownReplacements(context.Context) bool
+	})
+
+	return ok && c.WarnOnUnknownReplacements(ctx)
+}
+
+// privateStateKey is the key of the provider-defined data in a resource's private state.
+// The other keys are managed by Plugin SDK V2.
+const privateStateKey = "terraform-provider-aws"
+
+// decodePrivateState returns the provider-defined data in the specified JSON-encoded private state.
+func decodePrivateState(b []byte) (*sdkv2.PrivateState, error) {
+	var private map[string]json.RawMessage
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &private); err != nil {
+			return nil, err
+		}
+	}
+
+	var data map[string]json.RawMessage
+	if v, ok := private[privateStateKey]; ok {
+		if err := json.Unmarshal(v, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	m := make(map[string][]byte, len(data))
+	for k, v := range data {
+		m[k] = v
+	}
+
+	return sdkv2.NewPrivateState(m), nil
+}
+
+// encodePrivateState sets the provider-defined data in the specified JSON-encoded private state.
+func encodePrivateState(b []byte, p *sdkv2.PrivateState) ([]byte, error) {
+	var private map[string]json.RawMessage
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &private); err != nil {
+			return nil, err
+		}
+	}
+	if private == nil {
+		private = make(map[string]json.RawMessage)
+	}
+
+	data := make(map[string]json.RawMessage)
+	for k, v := range p.Data() {
+		data[k] = v
+	}
+
+	if len(data) == 0 {
+		delete(private, privateStateKey)
+	} else {
+		v, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		private[privateStateKey] = v
+	}
+
+	return json.Marshal(private)
+}
+
+func privateStateErrorDiagnostic(err error) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Invalid private state",
+		Detail:   fmt.Sprintf("The resource's private state could not be processed: %s", err),
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestGRPCProviderServer_writeOnlyValues(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "test_resource"
+	passwordPath := cty.GetAttrPath("password_wo")
+
+	var passwordChanged bool
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password_wo": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+			},
+			flex.AttrWriteOnlyVersion: flex.WriteOnlyVersionSchema(),
+		},
+		CustomizeDiff: flex.WriteOnlyValuesCustomizeDiff(passwordPath),
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId("id")
+			return flex.SetWriteOnlyValues(ctx, d, passwordPath)
+		},
+		ReadWithoutTimeout: schema.NoopContext,
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			passwordChanged = flex.HasWriteOnlyValueChange(ctx, d, passwordPath)
+			return flex.SetWriteOnlyValues(ctx, d, passwordPath)
+		},
+		DeleteWithoutTimeout: schema.NoopContext,
+	}
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	ctx := t.Context()
+	server := NewGRPCProviderServer(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			typeName: r,
+		},
+	})()
+
+	config := func(password string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			names.AttrID:              cty.NullVal(cty.String),
+			"password_wo":             cty.StringVal(password),
+			flex.AttrWriteOnlyVersion: cty.NullVal(cty.Number),
+		})
+	}
+
+	// apply plans and applies the configuration, returning the new state and private state.
+	apply := func(t *testing.T, state cty.Value, private []byte, config cty.Value) (cty.Value, []byte, bool) {
+		t.Helper()
+
+		// The proposed new state is the configuration merged with the prior state's computed values, without write-only values.
+		proposed := state
+		if state.IsNull() {
+			m := config.AsValueMap()
+			m["password_wo"] = cty.NullVal(cty.String)
+			proposed = cty.ObjectVal(m)
+		}
+
+		planResponse, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         typeName,
+			PriorState:       dynamicValue(t, state, ty),
+			PriorPrivate:     private,
+			ProposedNewState: dynamicValue(t, proposed, ty),
+			Config:           dynamicValue(t, config, ty),
+		})
+		if err != nil {
+			t.Fatalf("planning: %s", err)
+		}
+		if len(planResponse.Diagnostics) > 0 {
+			t.Fatalf("planning: %s: %s", planResponse.Diagnostics[0].Summary, planResponse.Diagnostics[0].Detail)
+		}
+
+		planned, err := msgpack.Unmarshal(planResponse.PlannedState.MsgPack, ty)
+		if err != nil {
+			t.Fatalf("decoding planned state: %s", err)
+		}
+		if planned.RawEquals(state) {
+			return state, private, false
+		}
+
+		applyResponse, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+			TypeName:       typeName,
+			PriorState:     dynamicValue(t, state, ty),
+			PlannedState:   planResponse.PlannedState,
+			PlannedPrivate: planResponse.PlannedPrivate,
+			Config:         dynamicValue(t, config, ty),
+		})
+		if err != nil {
+			t.Fatalf("applying: %s", err)
+		}
+		if len(applyResponse.Diagnostics) > 0 {
+			t.Fatalf("applying: %s: %s", applyResponse.Diagnostics[0].Summary, applyResponse.Diagnostics[0].Detail)
+		}
+
+		newState, err := msgpack.Unmarshal(applyResponse.NewState.MsgPack, ty)
+		if err != nil {
+			t.Fatalf("decoding new state: %s", err)
+		}
+
+		return newState, applyResponse.Private, true
+	}
+
+	version := func(v cty.Value) int64 {
+		i, _ := v.GetAttr(flex.AttrWriteOnlyVersion).AsBigFloat().Int64()
+		return i
+	}
+
+	// Create.
+	state, private, applied := apply(t, cty.NullVal(ty), nil, config("secret1"))
+	if !applied {
+		t.Fatal("create not planned")
+	}
+	if got, want := version(state), int64(1); got != want {
+		t.Errorf("version after create = %d, want %d", got, want)
+	}
+	if !bytes.Contains(private, []byte(privateStateKey)) {
+		t.Errorf("private state %s does not contain provider-defined data", private)
+	}
+	hash := sha256.Sum256([]byte("secret1"))
+	if bytes.Contains(private, []byte("secret1")) || bytes.Contains(private, []byte(hex.EncodeToString(hash[:]))) {
+		t.Errorf("private state %s contains the write-only value or its unkeyed hash", private)
+	}
+
+	// Private state is preserved on refresh.
+	readResponse, err := server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: dynamicValue(t, state, ty),
+		Private:      private,
+	})
+	if err != nil {
+		t.Fatalf("reading: %s", err)
+	}
+	if got, want := readResponse.Private, private; !bytes.Equal(got, want) {
+		t.Errorf("private state after refresh = %s, want %s", got, want)
+	}
+
+	// No change.
+	if _, _, applied := apply(t, state, private, config("secret1")); applied {
+		t.Error("update planned for unchanged write-only value")
+	}
+
+	// Update.
+	state, private, applied = apply(t, state, private, config("secret2"))
+	if !applied {
+		t.Fatal("update not planned for changed write-only value")
+	}
+	if !passwordChanged {
+		t.Error("write-only value change not detected on update")
+	}
+	if got, want := version(state), int64(2); got != want {
+		t.Errorf("version after update = %d, want %d", got, want)
+	}
+
+	// No change after update.
+	if _, _, applied := apply(t, state, private, config("secret2")); applied {
+		t.Error("update planned for unchanged write-only value after update")
+	}
+}
@@ -315,14 +315,26 @@ func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) PreventDestroyConfig(ctx context.Context) *tftags.PreventDestroyConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ValidateInContextAssumeRoleARN(ctx context.Context) error {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) WarnOnUnknownReplacements(ctx context.Context) bool {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	panic("not implemented") //lintignore:R009
 }
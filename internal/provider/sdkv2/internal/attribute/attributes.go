@@ -18,3 +18,19 @@ var Region = sync.OnceValue(func() *schema.Schema {
 		Description: names.ResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var AssumeRoleARN = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: names.ResourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
+
+var DataSourceAssumeRoleARN = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: names.DataSourceTopLevelAssumeRoleARNAttributeDescription,
+	}
+})
@@ -173,6 +173,13 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"prevent_destroy_tags": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "Resource tags that protect resources from deletion. " +
+						"Deleting or replacing a resource whose tags include any of these tags, with a matching value, fails.",
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -291,6 +298,11 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Product details to append to the User-Agent string sent in all AWS API calls.",
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
+				"warn_on_unknown_replacements": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Warn when a resource is planned for replacement because an argument that forces replacement depends on a value that is not known until apply.",
+				},
 			},
 
 			// ProviderMetaSchema enables module-scoped User-Agent modifications
@@ -385,6 +397,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		WarnOnUnknownReplacements:      d.Get("warn_on_unknown_replacements").(bool),
 	}
 
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
@@ -496,6 +509,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("prevent_destroy_tags"); ok && len(v.(map[string]any)) > 0 {
+		config.PreventDestroyConfig = &tftags.PreventDestroyConfig{
+			Tags: tftags.New(ctx, v.(map[string]any)),
+		}
+	}
+
 	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
@@ -610,6 +629,33 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			isAssumeRoleOverrideEnabled := v.AssumeRole.IsOverrideEnabled
+
+			if isAssumeRoleOverrideEnabled {
+				s := r.SchemaMap()
+
+				if _, ok := s[names.AttrAssumeRoleARN]; !ok {
+					// Inject a top-level "assume_role_arn" attribute.
+					assumeRoleARNSchema := attribute.DataSourceAssumeRoleARN()
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+					}
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Read,
+					interceptor: dataSourceValidateAssumeRole(),
+				})
+			}
+
 			if !tfunique.IsHandleNil(v.Tags) {
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before | After,
@@ -629,6 +675,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+					if isAssumeRoleOverrideEnabled && getAttribute != nil {
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok && roleARN != nil {
+							ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN.(string))
+						}
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -689,6 +740,13 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			if v := resource.EventualConsistency; v.IsEnabled() {
+				// After interceptors are run last to first, so this interceptor's Read sees the results of all others.
+				interceptors = append(interceptors, newEventualConsistencyInterceptor(typeName, func() schema.ReadContextFunc {
+					return r.ReadWithoutTimeout
+				}, v))
+			}
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -745,6 +803,43 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				}
 			}
 
+			isAssumeRoleOverrideEnabled := resource.AssumeRole.IsOverrideEnabled
+
+			if isAssumeRoleOverrideEnabled {
+				s := r.SchemaMap()
+
+				if _, ok := s[names.AttrAssumeRoleARN]; !ok {
+					// Inject a top-level "assume_role_arn" attribute.
+					assumeRoleARNSchema := attribute.AssumeRoleARN()
+
+					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+					if r.UpdateWithoutTimeout == nil {
+						r.UpdateWithoutTimeout = schema.NoopContext
+					}
+
+					if f := r.SchemaFunc; f != nil {
+						r.SchemaFunc = func() map[string]*schema.Schema {
+							s := f()
+							s[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+							return s
+						}
+					} else {
+						r.Schema[names.AttrAssumeRoleARN] = assumeRoleARNSchema
+					}
+				}
+
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: resourceValidateAssumeRole(),
+				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: forceNewIfAssumeRoleAccountChanges(),
+				})
+			}
+
 			if !tfunique.IsHandleNil(resource.Tags) {
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before | After | Finally,
@@ -761,6 +856,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: validateRequiredTags(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Delete,
+					interceptor: resourcePreventDestroy(),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -810,6 +910,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					if isAssumeRoleOverrideEnabled && getAttribute != nil {
+						if roleARN, ok := getAttribute(names.AttrAssumeRoleARN); ok && roleARN != nil {
+							ctx = conns.WithOverrideAssumeRoleARN(ctx, roleARN.(string))
+						}
+					}
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -856,6 +961,13 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 				}
 			}
 
+			if v.AssumeRole.IsOverrideEnabled {
+				if _, ok := s[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
+			}
+
 			if !tfunique.IsHandleNil(v.Tags) {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -883,6 +995,13 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 				}
 			}
 
+			if resource.AssumeRole.IsOverrideEnabled {
+				if _, ok := s[names.AttrAssumeRoleARN]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrAssumeRoleARN, typeName))
+					continue
+				}
+			}
+
 			if !tfunique.IsHandleNil(resource.Tags) {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -362,3 +362,42 @@ func validateRequiredTags() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+// resourcePreventDestroy prevents the deletion of resources whose last-known tags match any provider configured prevent_destroy_tags.
+// Resource replacement also deletes the existing resource, so is prevented too.
+func resourcePreventDestroy() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		c := opts.c
+		var diags diag.Diagnostics
+
+		config := c.PreventDestroyConfig(ctx)
+		if config == nil {
+			return diags
+		}
+
+		_, serviceName, resourceName, _, _, ok := interceptors.InfoFromContext(ctx, c)
+		if !ok {
+			return diags
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				var tags tftags.KeyValueTags
+				if v, ok := d.Get(names.AttrTagsAll).(map[string]any); ok {
+					tags = tftags.New(ctx, v)
+				}
+
+				if matches := config.Matches(tags); len(matches) > 0 {
+					keys := matches.Keys()
+					slices.Sort(keys)
+
+					return sdkdiag.AppendErrorf(diags, "deleting %s %s (%s): prevented by the provider's prevent_destroy_tags configuration, matching tags: %s", serviceName, resourceName, d.Id(), keys)
+				}
+			}
+		}
+
+		return diags
+	})
+}
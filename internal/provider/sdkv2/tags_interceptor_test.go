@@ -111,6 +111,73 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestResourcePreventDestroyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := &conns.AWSClient{}
+	conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+		"Test": &mockService{},
+	})
+	conns.SetPreventDestroyConfig(conn, &tftags.PreventDestroyConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"protected": "true",
+		}),
+	})
+
+	ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+	ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig(ctx), conn.IgnoreTagsConfig(ctx), conn.TagPolicyConfig(ctx))
+
+	testCases := map[string]struct {
+		tagsAll     map[string]any
+		expectError bool
+	}{
+		"no tags": {},
+		"matching tag": {
+			tagsAll: map[string]any{
+				"protected": "true",
+				"other":     "value",
+			},
+			expectError: true,
+		},
+		"matching key different value": {
+			tagsAll: map[string]any{
+				"protected": "false",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := crudInterceptorOptions{
+				c:    conn,
+				d:    &tagsAllResourceData{tagsAll: testCase.tagsAll},
+				when: Before,
+				why:  Delete,
+			}
+			diags := resourcePreventDestroy().run(ctx, opts)
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+type tagsAllResourceData struct {
+	resourceData
+	tagsAll map[string]any
+}
+
+func (d *tagsAllResourceData) Get(key string) any {
+	if key == "tags_all" {
+		return d.tagsAll
+	}
+
+	return nil
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// unknownForceNewDiagnostics returns warnings for top-level or nested ForceNew attributes whose configured value is unknown at plan time
+// because it depends on a change to another resource or data source.
+// Such replacements are planned even if the value turns out to be unchanged after apply.
+func unknownForceNewDiagnostics(r *schema.Resource, request *tfprotov5.PlanResourceChangeRequest, requiresReplace []*tftypes.AttributePath) []*tfprotov5.Diagnostic {
+	if request.Config == nil || request.PriorState == nil {
+		return nil
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	// Only updates can cause replacement.
+	state, err := msgpack.Unmarshal(request.PriorState.MsgPack, ty)
+	if err != nil || state.IsNull() {
+		return nil
+	}
+
+	config, err := msgpack.Unmarshal(request.Config.MsgPack, ty)
+	if err != nil || config.IsNull() {
+		return nil
+	}
+
+	var diags []*tfprotov5.Diagnostic
+	seen := make(map[string]bool)
+	for _, p := range requiresReplace {
+		if seen[p.String()] {
+			continue
+		}
+		seen[p.String()] = true
+
+		path, ok := ctyPath(p)
+		if !ok {
+			continue
+		}
+
+		// Ignore any errors, e.g. for elements that were added or removed.
+		if v, err := path.Apply(config); err != nil || v.IsKnown() {
+			continue
+		}
+
+		if v, err := path.Apply(state); err != nil || v.IsNull() || !v.IsWhollyKnown() {
+			continue
+		}
+
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Resource replacement depends on an unknown value",
+			Detail: fmt.Sprintf("The value of %q is not known until apply because it depends on a change to another resource or data source. "+
+				"As changing %[1]q requires replacement, the resource will be replaced, even if the value is unchanged after apply.", p),
+			Attribute: p,
+		})
+	}
+
+	return diags
+}
+
+// ctyPath converts a terraform-plugin-go attribute path to a go-cty path.
+// Paths through set elements can't be converted.
+func ctyPath(p *tftypes.AttributePath) (cty.Path, bool) {
+	var path cty.Path
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			path = path.GetAttr(string(step))
+		case tftypes.ElementKeyString:
+			path = path.Index(cty.StringVal(string(step)))
+		case tftypes.ElementKeyInt:
+			path = path.Index(cty.NumberVal(new(big.Float).SetInt64(int64(step))))
+		default:
+			return nil, false
+		}
+	}
+
+	return path, true
+}
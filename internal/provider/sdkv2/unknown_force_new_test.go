@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type unknownReplacementsClient bool
+
+func (c unknownReplacementsClient) WarnOnUnknownReplacements(context.Context) bool {
+	return bool(c)
+}
+
+func TestGRPCProviderServerPlanResourceChange_unknownForceNew(t *testing.T) {
+	t.Parallel()
+
+	const typeName = "test_resource"
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"force_new": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"nested": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"force_new": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"updatable": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CreateWithoutTimeout: schema.NoopContext,
+		ReadWithoutTimeout:   schema.NoopContext,
+		UpdateWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: schema.NoopContext,
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	object := func(id, forceNew, nestedForceNew, updatable cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			names.AttrID: id,
+			"force_new":  forceNew,
+			"nested": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"force_new": nestedForceNew,
+			})}),
+			"updatable": updatable,
+		})
+	}
+
+	state := object(cty.StringVal("id"), cty.StringVal("old"), cty.StringVal("old"), cty.StringVal("old"))
+
+	testCases := map[string]struct {
+		config  cty.Value
+		state   cty.Value
+		enabled bool
+		want    []string
+	}{
+		"known": {
+			config:  object(cty.NullVal(cty.String), cty.StringVal("new"), cty.StringVal("old"), cty.StringVal("old")),
+			state:   state,
+			enabled: true,
+		},
+		"unknown top-level": {
+			config:  object(cty.NullVal(cty.String), cty.UnknownVal(cty.String), cty.StringVal("old"), cty.StringVal("old")),
+			state:   state,
+			enabled: true,
+			want: []string{
+				tftypes.NewAttributePath().WithAttributeName("force_new").String(),
+			},
+		},
+		"unknown nested": {
+			config:  object(cty.NullVal(cty.String), cty.StringVal("old"), cty.UnknownVal(cty.String), cty.StringVal("old")),
+			state:   state,
+			enabled: true,
+			want: []string{
+				tftypes.NewAttributePath().WithAttributeName("nested").WithElementKeyInt(0).WithAttributeName("force_new").String(),
+			},
+		},
+		"unknown not ForceNew": {
+			config:  object(cty.NullVal(cty.String), cty.StringVal("old"), cty.StringVal("old"), cty.UnknownVal(cty.String)),
+			state:   state,
+			enabled: true,
+		},
+		"unknown disabled": {
+			config: object(cty.NullVal(cty.String), cty.UnknownVal(cty.String), cty.StringVal("old"), cty.StringVal("old")),
+			state:  state,
+		},
+		"create": {
+			config:  object(cty.NullVal(cty.String), cty.UnknownVal(cty.String), cty.StringVal("old"), cty.StringVal("old")),
+			state:   cty.NullVal(ty),
+			enabled: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			p := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					typeName: r,
+				},
+			}
+			p.SetMeta(unknownReplacementsClient(testCase.enabled))
+
+			// The proposed new state is the configuration merged with the prior state's computed values.
+			proposed := testCase.config
+			if !testCase.state.IsNull() {
+				m := proposed.AsValueMap()
+				m[names.AttrID] = testCase.state.GetAttr(names.AttrID)
+				proposed = cty.ObjectVal(m)
+			}
+
+			response, err := NewGRPCProviderServer(p)().PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         typeName,
+				PriorState:       dynamicValue(t, testCase.state, ty),
+				ProposedNewState: dynamicValue(t, proposed, ty),
+				Config:           dynamicValue(t, testCase.config, ty),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, d := range response.Diagnostics {
+				if d.Severity != tfprotov5.DiagnosticSeverityWarning {
+					t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+				}
+				got = append(got, d.Attribute.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func dynamicValue(t *testing.T, v cty.Value, ty cty.Type) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	b, err := msgpack.Marshal(v, ty)
+	if err != nil {
+		t.Fatalf("marshaling %#v: %s", v, err)
+	}
+
+	return &tfprotov5.DynamicValue{MsgPack: b}
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// FinderFuncOf is a function type that finds a single resource.
+// A resource that does not exist is indicated by a NotFoundError.
+type FinderFuncOf[T any] func(context.Context) (T, error)
+
+// WaitFuncOf is a function type that waits for a resource's state change.
+type WaitFuncOf[T any] func(context.Context, FinderFuncOf[T], time.Duration) (T, error)
+
+// StateWaiterOf declaratively describes how to wait for state changes of a type of resource.
+// It produces created, updated and deleted waiters with consistent error messages and progress logging.
+//
+// For example:
+//
+//	var thingWaiter = retry.StateWaiterOf[*awstypes.Thing, awstypes.ThingStatus]{
+//		Name:          "Example Thing",
+//		Status:        func(v *awstypes.Thing) awstypes.ThingStatus { return v.Status },
+//		StatusReason:  func(v *awstypes.Thing) string { return aws.ToString(v.StatusReason) },
+//		FailureStates: []awstypes.ThingStatus{awstypes.ThingStatusFailed},
+//	}
+//
+//	func waitThingCreated(ctx context.Context, conn *example.Client, id string, timeout time.Duration) (*awstypes.Thing, error) {
+//		return thingWaiter.Created([]awstypes.ThingStatus{awstypes.ThingStatusCreating}, []awstypes.ThingStatus{awstypes.ThingStatusActive})(ctx, func(ctx context.Context) (*awstypes.Thing, error) {
+//			return findThingByID(ctx, conn, id)
+//		}, timeout)
+//	}
+type StateWaiterOf[T any, S ~string] struct {
+	Name          string         // Friendly resource name used in log messages, e.g. "Example Thing"
+	Status        func(T) S      // Returns the resource's current status
+	StatusReason  func(T) string // Optional. Returns the reason for the resource's current status
+	FailureStates []S            // Statuses in which waiting fails immediately
+
+	Delay                     time.Duration // Wait this time before starting checks
+	MinTimeout                time.Duration // Smallest time to wait before refreshes
+	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often
+	NotFoundChecks            int           // Number of times to allow not found
+	ContinuousTargetOccurence int           // Number of times the target status has to occur continuously
+}
+
+// Created returns a waiter that waits for a newly created resource to move from any of the `pending` statuses
+// to any of the `target` statuses.
+func (w StateWaiterOf[T, S]) Created(pending, target []S) WaitFuncOf[T] {
+	return w.waiter("create", pending, target)
+}
+
+// Updated returns a waiter that waits for an updated resource to move from any of the `pending` statuses
+// to any of the `target` statuses.
+func (w StateWaiterOf[T, S]) Updated(pending, target []S) WaitFuncOf[T] {
+	return w.waiter("update", pending, target)
+}
+
+// Deleted returns a waiter that waits for a deleted resource to move from any of the `pending` statuses
+// to no longer being found.
+func (w StateWaiterOf[T, S]) Deleted(pending []S) WaitFuncOf[T] {
+	return w.waiter("delete", pending, nil)
+}
+
+func (w StateWaiterOf[T, S]) waiter(operation string, pending, target []S) WaitFuncOf[T] {
+	return func(ctx context.Context, find FinderFuncOf[T], timeout time.Duration) (T, error) {
+		ctx = tflog.SetField(ctx, "waiter_resource", w.Name)
+		ctx = tflog.SetField(ctx, "waiter_operation", operation)
+
+		// The last resource found, used to report the status reason on timeout.
+		var last T
+
+		stateConf := &StateChangeConfOf[T, S]{
+			Pending: pending,
+			Target:  target,
+			Refresh: func(ctx context.Context) (T, S, error) {
+				output, err := find(ctx)
+
+				if NotFound(err) {
+					tflog.Debug(ctx, "waiting for state change", map[string]any{
+						"status": "not found",
+					})
+
+					return inttypes.Zero[T](), "", nil
+				}
+
+				if err != nil {
+					return inttypes.Zero[T](), "", err
+				}
+
+				last = output
+				status := w.Status(output)
+
+				fields := map[string]any{
+					"status": status,
+				}
+				if reason := w.statusReason(output); reason != "" {
+					fields["status_reason"] = reason
+				}
+				tflog.Debug(ctx, "waiting for state change", fields)
+
+				if slices.Contains(w.FailureStates, status) {
+					return output, status, &UnexpectedStateError{
+						State:         string(status),
+						ExpectedState: tfslices.Strings(target),
+					}
+				}
+
+				return output, status, nil
+			},
+			Timeout:                   timeout,
+			Delay:                     w.Delay,
+			MinTimeout:                w.MinTimeout,
+			PollInterval:              w.PollInterval,
+			NotFoundChecks:            w.NotFoundChecks,
+			ContinuousTargetOccurence: w.ContinuousTargetOccurence,
+		}
+
+		output, err := stateConf.WaitForStateContext(ctx)
+
+		if err != nil {
+			if !inttypes.IsZero(output) {
+				last = output
+			}
+			if !inttypes.IsZero(last) {
+				if reason := w.statusReason(last); reason != "" {
+					SetLastError(err, errors.New(reason))
+				}
+			}
+
+			tflog.Debug(ctx, "state change failed", map[string]any{
+				"error": err.Error(),
+			})
+
+			return output, err
+		}
+
+		tflog.Debug(ctx, "state change complete")
+
+		return output, nil
+	}
+}
+
+func (w StateWaiterOf[T, S]) statusReason(v T) string {
+	if w.StatusReason == nil {
+		return ""
+	}
+
+	return w.StatusReason(v)
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type waiterTestStatus string
+
+const (
+	waiterTestStatusCreating waiterTestStatus = "CREATING"
+	waiterTestStatusActive   waiterTestStatus = "ACTIVE"
+	waiterTestStatusDeleting waiterTestStatus = "DELETING"
+	waiterTestStatusFailed   waiterTestStatus = "FAILED"
+)
+
+type waiterTestThing struct {
+	status waiterTestStatus
+	reason string
+}
+
+var waiterTestThingWaiter = StateWaiterOf[*waiterTestThing, waiterTestStatus]{
+	Name:          "Test Thing",
+	Status:        func(v *waiterTestThing) waiterTestStatus { return v.status },
+	StatusReason:  func(v *waiterTestThing) string { return v.reason },
+	FailureStates: []waiterTestStatus{waiterTestStatusFailed},
+	PollInterval:  10 * time.Millisecond,
+}
+
+// waiterTestFinder returns a finder that returns each of the specified things in turn, and then the last repeatedly.
+// A nil thing is not found.
+func waiterTestFinder(things ...*waiterTestThing) FinderFuncOf[*waiterTestThing] {
+	i := 0
+
+	return func(context.Context) (*waiterTestThing, error) {
+		thing := things[min(i, len(things)-1)]
+		i++
+
+		if thing == nil {
+			return nil, &NotFoundError{}
+		}
+
+		return thing, nil
+	}
+}
+
+func TestStateWaiterOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		waiter       WaitFuncOf[*waiterTestThing]
+		find         FinderFuncOf[*waiterTestThing]
+		timeout      time.Duration
+		expectStatus waiterTestStatus
+		expectError  string
+	}{
+		"created": {
+			waiter: waiterTestThingWaiter.Created([]waiterTestStatus{waiterTestStatusCreating}, []waiterTestStatus{waiterTestStatusActive}),
+			find: waiterTestFinder(
+				nil,
+				&waiterTestThing{status: waiterTestStatusCreating},
+				&waiterTestThing{status: waiterTestStatusActive},
+			),
+			timeout:      time.Second,
+			expectStatus: waiterTestStatusActive,
+		},
+		"created failure state": {
+			waiter: waiterTestThingWaiter.Created([]waiterTestStatus{waiterTestStatusCreating}, []waiterTestStatus{waiterTestStatusActive}),
+			find: waiterTestFinder(
+				&waiterTestThing{status: waiterTestStatusCreating},
+				&waiterTestThing{status: waiterTestStatusFailed, reason: "quota exceeded"},
+			),
+			timeout:      time.Second,
+			expectStatus: waiterTestStatusFailed,
+			expectError:  "unexpected state 'FAILED', wanted target 'ACTIVE'. last error: quota exceeded",
+		},
+		"updated timeout": {
+			waiter: waiterTestThingWaiter.Updated([]waiterTestStatus{waiterTestStatusCreating}, []waiterTestStatus{waiterTestStatusActive}),
+			find: waiterTestFinder(
+				&waiterTestThing{status: waiterTestStatusCreating, reason: "provisioning capacity"},
+			),
+			timeout:     100 * time.Millisecond,
+			expectError: "timeout while waiting for state to become 'ACTIVE' (last state: 'CREATING', timeout: 100ms): provisioning capacity",
+		},
+		"deleted": {
+			waiter: waiterTestThingWaiter.Deleted([]waiterTestStatus{waiterTestStatusActive, waiterTestStatusDeleting}),
+			find: waiterTestFinder(
+				&waiterTestThing{status: waiterTestStatusDeleting},
+				nil,
+			),
+			timeout: time.Second,
+		},
+		"find error": {
+			waiter: waiterTestThingWaiter.Deleted([]waiterTestStatus{waiterTestStatusActive, waiterTestStatusDeleting}),
+			find: func(context.Context) (*waiterTestThing, error) {
+				return nil, errors.New("access denied")
+			},
+			timeout:     time.Second,
+			expectError: "access denied",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := tc.waiter(t.Context(), tc.find, tc.timeout)
+
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got, want := err.Error(), tc.expectError; got != want {
+					t.Errorf("expected error %q, got %q", want, got)
+				}
+			}
+
+			var status waiterTestStatus
+			if output != nil {
+				status = output.status
+			}
+			if got, want := status, tc.expectStatus; got != want {
+				t.Errorf("expected status %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// PrivateState is provider-defined resource private state data.
+// Plugin SDK V2 doesn't expose private state to resources, so the provider's protocol server
+// makes it available to CustomizeDiff functions and CRUD handlers via the request context.
+// Private state is persisted in the Terraform state but is not shown in plan output.
+// Values are JSON-encoded.
+type PrivateState struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+// NewPrivateState returns a PrivateState populated with the specified data.
+func NewPrivateState(data map[string][]byte) *PrivateState {
+	return &PrivateState{
+		data: maps.Clone(data),
+	}
+}
+
+// GetKey returns the private state data associated with the given key.
+func (p *PrivateState) GetKey(key string) []byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.data[key]
+}
+
+// SetKey sets the private state data at the given key.
+// An empty value removes the key.
+func (p *PrivateState) SetKey(key string, value []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(value) == 0 {
+		delete(p.data, key)
+		return
+	}
+
+	if p.data == nil {
+		p.data = make(map[string][]byte)
+	}
+	p.data[key] = value
+}
+
+// Data returns a copy of all private state data.
+func (p *PrivateState) Data() map[string][]byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return maps.Clone(p.data)
+}
+
+type privateStateKey struct{}
+
+// ContextWithPrivateState returns a new context with the specified private state.
+func ContextWithPrivateState(ctx context.Context, p *PrivateState) context.Context {
+	return context.WithValue(ctx, privateStateKey{}, p)
+}
+
+// PrivateStateFromContext returns the private state stored in the context, if any.
+func PrivateStateFromContext(ctx context.Context) (*PrivateState, bool) {
+	p, ok := ctx.Value(privateStateKey{}).(*PrivateState)
+	return p, ok
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrivateState(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if _, ok := PrivateStateFromContext(ctx); ok {
+		t.Fatal("unexpected private state in context")
+	}
+
+	ctx = ContextWithPrivateState(ctx, NewPrivateState(map[string][]byte{
+		"key1": []byte(`"value1"`),
+	}))
+
+	p, ok := PrivateStateFromContext(ctx)
+	if !ok {
+		t.Fatal("expected private state in context")
+	}
+
+	p.SetKey("key2", []byte(`"value2"`))
+	p.SetKey("key1", nil)
+
+	if got := p.GetKey("key1"); got != nil {
+		t.Errorf("got %q for removed key", got)
+	}
+
+	if diff := cmp.Diff(p.Data(), map[string][]byte{
+		"key2": []byte(`"value2"`),
+	}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
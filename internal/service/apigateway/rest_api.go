@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -28,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -46,6 +48,7 @@ func resourceRestAPI() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("detect_body_drift", false)
 				d.Set("put_rest_api_mode", types.PutModeOverwrite)
 				return []*schema.ResourceData{d}, nil
 			},
@@ -68,6 +71,16 @@ func resourceRestAPI() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"body_drift": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"body_drift_unresolved": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"body": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -86,6 +99,11 @@ func resourceRestAPI() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"detect_body_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"endpoint_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -170,7 +188,10 @@ func resourceRestAPI() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: endpointConfigurationPlantimeValidate,
+		CustomizeDiff: customdiff.All(
+			endpointConfigurationPlantimeValidate,
+			bodyDriftCustomizeDiff,
+		),
 	}
 }
 
@@ -323,6 +344,23 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) root resource: %s", d.Id(), err)
 	}
 
+	if body, ok := d.GetOk("body"); ok && d.Get("detect_body_drift").(bool) {
+		drift, err := restAPIBodyDrift(ctx, conn, d.Id(), body.(string), types.PutMode(modeConfigOrDefault(d)))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) body drift: %s", d.Id(), err)
+		}
+
+		d.Set("body_drift", drift)
+
+		if len(drift) > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "API Gateway REST API (%s) resources have drifted from the OpenAPI definition in body:\n\n%s", d.Id(), strings.Join(drift, "\n"))
+		}
+	} else {
+		d.Set("body_drift", nil)
+		d.Set("body_drift_unresolved", nil)
+	}
+
 	policy, err := flattenAPIPolicy(api.Policy)
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -506,7 +544,9 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			}
 		}
 
-		if d.HasChanges("body", names.AttrParameters) {
+		// The OpenAPI definition is only imported again on drift or a put_rest_api_mode change if drift detection is enabled.
+		detectBodyDrift := d.Get("detect_body_drift").(bool)
+		if d.HasChanges("body", names.AttrParameters) || (detectBodyDrift && d.HasChanges("body_drift", "detect_body_drift", "put_rest_api_mode")) {
 			if body, ok := d.GetOk("body"); ok {
 				// Terraform implementation uses the `overwrite` mode by default.
 				// Overwrite mode will delete existing literal properties if they are not explicitly set in the OpenAPI definition.
@@ -548,6 +588,17 @@ func resourceRestAPIUpdate(ctx context.Context, d *schema.ResourceData, meta any
 						return sdkdiag.AppendErrorf(diags, "updating API Gateway REST API (%s) after OpenAPI import: %s", d.Id(), err)
 					}
 				}
+
+				// Record any drift that importing the OpenAPI definition again didn't resolve so that it doesn't cause a perpetual diff.
+				var unresolved []string
+				if detectBodyDrift {
+					unresolved, err = restAPIBodyDrift(ctx, conn, d.Id(), body.(string), types.PutMode(modeConfigOrDefault(d)))
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) body drift: %s", d.Id(), err)
+					}
+				}
+				d.Set("body_drift_unresolved", unresolved)
 			}
 		}
 	}
@@ -588,6 +639,65 @@ func endpointConfigurationPlantimeValidate(_ context.Context, diff *schema.Resou
 	return nil
 }
 
+// bodyDriftCustomizeDiff plans a re-import of the OpenAPI definition when drift has been detected
+// in the resources and methods created from it.
+// Drift that remained after the last re-import isn't resolvable by importing again and is ignored.
+func bodyDriftCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.Get("detect_body_drift").(bool) {
+		return nil
+	}
+
+	if diff.HasChanges("body", "detect_body_drift", "put_rest_api_mode") || hasNewBodyDrift(diff.Get("body_drift").([]any), diff.Get("body_drift_unresolved").([]any)) {
+		if err := diff.SetNewComputed("body_drift"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("body_drift_unresolved")
+	}
+
+	return nil
+}
+
+// hasNewBodyDrift returns whether drift contains any entries that aren't in unresolved.
+func hasNewBodyDrift(drift, unresolved []any) bool {
+	seen := make(map[any]struct{}, len(unresolved))
+	for _, v := range unresolved {
+		seen[v] = struct{}{}
+	}
+
+	for _, v := range drift {
+		if _, ok := seen[v]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func restAPIBodyDrift(ctx context.Context, conn *apigateway.Client, id, body string, mode types.PutMode) ([]string, error) {
+	expected, err := expandOpenAPIResources(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := apigateway.GetResourcesInput{
+		Embed:     []string{"methods"},
+		RestApiId: aws.String(id),
+	}
+	resources, err := findResources(ctx, conn, &input, tfslices.PredicateTrue[*types.Resource]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return openAPIResourcesDrift(expected, flattenOpenAPIResources(resources), mode), nil
+}
+
 func findRestAPIByID(ctx context.Context, conn *apigateway.Client, id string) (*apigateway.GetRestApiOutput, error) {
 	input := apigateway.GetRestApiInput{
 		RestApiId: aws.String(id),
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+const (
+	openAPIExtensionAnyMethod   = "x-amazon-apigateway-any-method"
+	openAPIExtensionIntegration = "x-amazon-apigateway-integration"
+)
+
+// openAPIIntegration is the subset of an x-amazon-apigateway-integration object compared against a method's integration.
+type openAPIIntegration struct {
+	httpMethod      string
+	integrationType string
+	uri             string
+}
+
+// openAPIResources maps a resource path to the methods (and their integrations) defined on it.
+type openAPIResources map[string]map[string]*openAPIIntegration
+
+func decodeOpenAPIDefinition(v string) (map[string]any, error) {
+	var output map[string]any
+
+	err := tfjson.DecodeFromString(v, &output)
+
+	if err != nil {
+		err = tfyaml.DecodeFromString(v, &output)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// expandOpenAPIResources returns the resources, methods and integrations that importing the specified
+// OpenAPI (or Swagger) definition is expected to create.
+// Parent resources of each path are included with no methods.
+func expandOpenAPIResources(body string) (openAPIResources, error) {
+	definition, err := decodeOpenAPIDefinition(body)
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding OpenAPI definition: %w", err)
+	}
+
+	resources := openAPIResources{
+		"/": {},
+	}
+
+	paths, _ := definition["paths"].(map[string]any)
+
+	for path, v := range paths {
+		path = normalizeOpenAPIPath(path)
+
+		for parent := path; parent != "/"; {
+			if _, ok := resources[parent]; !ok {
+				resources[parent] = make(map[string]*openAPIIntegration)
+			}
+
+			parent = parent[:max(strings.LastIndex(parent, "/"), 1)]
+		}
+
+		pathItem, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for k, v := range pathItem {
+			var method string
+
+			switch k := strings.ToLower(k); k {
+			case openAPIExtensionAnyMethod:
+				method = "ANY"
+			case "delete", "get", "head", "options", "patch", "post", "put":
+				method = strings.ToUpper(k)
+			default:
+				continue
+			}
+
+			var integration *openAPIIntegration
+
+			if operation, ok := v.(map[string]any); ok {
+				if v, ok := operation[openAPIExtensionIntegration].(map[string]any); ok {
+					integration = expandOpenAPIIntegration(v)
+				}
+			}
+
+			resources[path][method] = integration
+		}
+	}
+
+	return resources, nil
+}
+
+func expandOpenAPIIntegration(tfMap map[string]any) *openAPIIntegration {
+	integration := &openAPIIntegration{}
+
+	if v, ok := tfMap["httpMethod"].(string); ok {
+		integration.httpMethod = v
+	}
+
+	if v, ok := tfMap["type"].(string); ok {
+		integration.integrationType = v
+	}
+
+	if v, ok := tfMap["uri"].(string); ok {
+		integration.uri = v
+	}
+
+	return integration
+}
+
+func normalizeOpenAPIPath(path string) string {
+	return "/" + strings.Trim(path, "/")
+}
+
+// flattenOpenAPIResources returns the methods and integrations of API Gateway resources
+// read with the "methods" embed.
+func flattenOpenAPIResources(apiObjects []types.Resource) openAPIResources {
+	resources := make(openAPIResources, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		methods := make(map[string]*openAPIIntegration, len(apiObject.ResourceMethods))
+
+		for method, v := range apiObject.ResourceMethods {
+			var integration *openAPIIntegration
+
+			if v := v.MethodIntegration; v != nil {
+				integration = &openAPIIntegration{
+					httpMethod:      aws.ToString(v.HttpMethod),
+					integrationType: string(v.Type),
+					uri:             aws.ToString(v.Uri),
+				}
+			}
+
+			methods[method] = integration
+		}
+
+		resources[aws.ToString(apiObject.Path)] = methods
+	}
+
+	return resources
+}
+
+// openAPIResourcesDrift compares the expected resources from an OpenAPI definition to the actual resources
+// of a REST API and returns a description of each difference, per path and method.
+// In "merge" mode, resources and methods that are not in the definition are not considered drift
+// as they are left untouched when the definition is imported.
+func openAPIResourcesDrift(expected, actual openAPIResources, mode types.PutMode) []string {
+	var drift []string
+
+	for _, path := range slices.Sorted(maps.Keys(expected)) {
+		expectedMethods := expected[path]
+		actualMethods, ok := actual[path]
+
+		if !ok {
+			drift = append(drift, fmt.Sprintf("%s: resource not found", path))
+			continue
+		}
+
+		for _, method := range slices.Sorted(maps.Keys(expectedMethods)) {
+			expectedIntegration := expectedMethods[method]
+			actualIntegration, ok := actualMethods[method]
+
+			if !ok {
+				drift = append(drift, fmt.Sprintf("%s %s: method not found", method, path))
+				continue
+			}
+
+			if expectedIntegration == nil {
+				continue
+			}
+
+			if actualIntegration == nil {
+				drift = append(drift, fmt.Sprintf("%s %s: integration not found", method, path))
+				continue
+			}
+
+			if expected, actual := expectedIntegration.integrationType, actualIntegration.integrationType; expected != "" && !strings.EqualFold(expected, actual) {
+				drift = append(drift, fmt.Sprintf("%s %s: integration type is %q, expected %q", method, path, actual, strings.ToUpper(expected)))
+			}
+
+			if expected, actual := expectedIntegration.httpMethod, actualIntegration.httpMethod; expected != "" && !strings.EqualFold(expected, actual) {
+				drift = append(drift, fmt.Sprintf("%s %s: integration HTTP method is %q, expected %q", method, path, actual, strings.ToUpper(expected)))
+			}
+
+			if expected, actual := expectedIntegration.uri, actualIntegration.uri; expected != "" && expected != actual {
+				drift = append(drift, fmt.Sprintf("%s %s: integration URI is %q, expected %q", method, path, actual, expected))
+			}
+		}
+
+		if mode == types.PutModeMerge {
+			continue
+		}
+
+		for _, method := range slices.Sorted(maps.Keys(actualMethods)) {
+			if _, ok := expectedMethods[method]; !ok {
+				drift = append(drift, fmt.Sprintf("%s %s: method not in definition", method, path))
+			}
+		}
+	}
+
+	if mode == types.PutModeMerge {
+		return drift
+	}
+
+	for _, path := range slices.Sorted(maps.Keys(actual)) {
+		if _, ok := expected[path]; !ok {
+			drift = append(drift, fmt.Sprintf("%s: resource not in definition", path))
+		}
+	}
+
+	return drift
+}
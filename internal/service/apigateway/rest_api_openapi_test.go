@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testOpenAPIDefinitionJSON = `{
+  "openapi": "3.0.1",
+  "info": {"title": "test", "version": "1"},
+  "paths": {
+    "/pets": {
+      "get": {
+        "x-amazon-apigateway-integration": {
+          "type": "http_proxy",
+          "httpMethod": "GET",
+          "uri": "https://example.com/pets"
+        }
+      },
+      "post": {}
+    },
+    "/pets/{petId}/toys/": {
+      "x-amazon-apigateway-any-method": {
+        "x-amazon-apigateway-integration": {
+          "type": "mock"
+        }
+      },
+      "parameters": []
+    }
+  }
+}`
+
+const testOpenAPIDefinitionYAML = `
+swagger: "2.0"
+info:
+  title: test
+  version: "1"
+paths:
+  /pets:
+    get:
+      x-amazon-apigateway-integration:
+        type: http_proxy
+        httpMethod: GET
+        uri: https://example.com/pets
+    post: {}
+  /pets/{petId}/toys/:
+    x-amazon-apigateway-any-method:
+      x-amazon-apigateway-integration:
+        type: mock
+    parameters: []
+`
+
+func TestExpandOpenAPIResources(t *testing.T) {
+	t.Parallel()
+
+	want := openAPIResources{
+		"/": {},
+		"/pets": {
+			"GET": {
+				httpMethod:      "GET",
+				integrationType: "http_proxy",
+				uri:             "https://example.com/pets",
+			},
+			"POST": nil,
+		},
+		"/pets/{petId}": {},
+		"/pets/{petId}/toys": {
+			"ANY": {
+				integrationType: "mock",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		body    string
+		want    openAPIResources
+		wantErr bool
+	}{
+		"json": {
+			body: testOpenAPIDefinitionJSON,
+			want: want,
+		},
+		"yaml": {
+			body: testOpenAPIDefinitionYAML,
+			want: want,
+		},
+		"no paths": {
+			body: `{"openapi": "3.0.1"}`,
+			want: openAPIResources{"/": {}},
+		},
+		"invalid": {
+			body:    `{"openapi": `,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandOpenAPIResources(testCase.body)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("expandOpenAPIResources() err %t, want %t: %s", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmp.AllowUnexported(openAPIIntegration{})); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOpenAPIResourcesDrift(t *testing.T) {
+	t.Parallel()
+
+	expected, err := expandOpenAPIResources(testOpenAPIDefinitionJSON)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inSync := []types.Resource{
+		{Path: aws.String("/")},
+		{Path: aws.String("/pets"), ResourceMethods: map[string]types.Method{
+			"GET":  {MethodIntegration: &types.Integration{HttpMethod: aws.String("GET"), Type: types.IntegrationTypeHttpProxy, Uri: aws.String("https://example.com/pets")}},
+			"POST": {},
+		}},
+		{Path: aws.String("/pets/{petId}")},
+		{Path: aws.String("/pets/{petId}/toys"), ResourceMethods: map[string]types.Method{
+			"ANY": {MethodIntegration: &types.Integration{Type: types.IntegrationTypeMock}},
+		}},
+	}
+
+	drifted := []types.Resource{
+		{Path: aws.String("/")},
+		{Path: aws.String("/pets"), ResourceMethods: map[string]types.Method{
+			"DELETE": {},
+			"GET":    {MethodIntegration: &types.Integration{HttpMethod: aws.String("POST"), Type: types.IntegrationTypeHttp, Uri: aws.String("https://example.com/other")}},
+		}},
+		{Path: aws.String("/pets/{petId}")},
+		{Path: aws.String("/users")},
+	}
+
+	testCases := map[string]struct {
+		actual []types.Resource
+		mode   types.PutMode
+		want   []string
+	}{
+		"in sync overwrite": {
+			actual: inSync,
+			mode:   types.PutModeOverwrite,
+		},
+		"in sync merge": {
+			actual: inSync,
+			mode:   types.PutModeMerge,
+		},
+		"drifted overwrite": {
+			actual: drifted,
+			mode:   types.PutModeOverwrite,
+			want: []string{
+				`GET /pets: integration type is "HTTP", expected "HTTP_PROXY"`,
+				`GET /pets: integration HTTP method is "POST", expected "GET"`,
+				`GET /pets: integration URI is "https://example.com/other", expected "https://example.com/pets"`,
+				`POST /pets: method not found`,
+				`DELETE /pets: method not in definition`,
+				`/pets/{petId}/toys: resource not found`,
+				`/users: resource not in definition`,
+			},
+		},
+		"drifted merge": {
+			actual: drifted,
+			mode:   types.PutModeMerge,
+			want: []string{
+				`GET /pets: integration type is "HTTP", expected "HTTP_PROXY"`,
+				`GET /pets: integration HTTP method is "POST", expected "GET"`,
+				`GET /pets: integration URI is "https://example.com/other", expected "https://example.com/pets"`,
+				`POST /pets: method not found`,
+				`/pets/{petId}/toys: resource not found`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := openAPIResourcesDrift(expected, flattenOpenAPIResources(testCase.actual), testCase.mode)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHasNewBodyDrift(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		drift      []any
+		unresolved []any
+		want       bool
+	}{
+		"no drift": {
+			want: false,
+		},
+		"new drift": {
+			drift: []any{"POST /pets: method not found"},
+			want:  true,
+		},
+		"unresolved drift": {
+			drift:      []any{"POST /pets: method not found"},
+			unresolved: []any{"POST /pets: method not found"},
+			want:       false,
+		},
+		"unresolved drift resolved": {
+			unresolved: []any{"POST /pets: method not found"},
+			want:       false,
+		},
+		"new and unresolved drift": {
+			drift:      []any{"POST /pets: method not found", "/pets/{petId}/toys: resource not found"},
+			unresolved: []any{"POST /pets: method not found"},
+			want:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := hasNewBodyDrift(testCase.drift, testCase.unresolved), testCase.want; got != want {
+				t.Errorf("hasNewBodyDrift = %t, want %t", got, want)
+			}
+		})
+	}
+}
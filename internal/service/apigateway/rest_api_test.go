@@ -509,6 +509,51 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_detectBodyDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf apigateway.GetRestApiOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRESTAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_detectBodyDrift(rName, "/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "body_drift.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "detect_body_drift", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_detectBodyDrift(rName, "/test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIDeleteMethod(ctx, &conf, "/test", "GET"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRestAPIConfig_detectBodyDrift(rName, "/test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
+					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
+					resource.TestCheckResourceAttr(resourceName, "body_drift.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -1518,6 +1563,37 @@ func testAccCheckRestAPIRoutes(ctx context.Context, conf *apigateway.GetRestApiO
 	}
 }
 
+func testAccCheckRestAPIDeleteMethod(ctx context.Context, conf *apigateway.GetRestApiOutput, path, method string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		input := apigateway.GetResourcesInput{
+			RestApiId: conf.Id,
+		}
+		resp, err := conn.GetResources(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resp.Items {
+			if aws.ToString(resource.Path) != path {
+				continue
+			}
+
+			input := apigateway.DeleteMethodInput{
+				HttpMethod: aws.String(method),
+				ResourceId: resource.Id,
+				RestApiId:  conf.Id,
+			}
+			_, err := conn.DeleteMethod(ctx, &input)
+
+			return err
+		}
+
+		return fmt.Errorf("Expected path %v but did not find it", path)
+	}
+}
+
 func testAccCheckRestAPIEndpointsCount(ctx context.Context, conf *apigateway.GetRestApiOutput, count int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
@@ -2377,6 +2453,45 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, basePath)
 }
 
+func testAccRestAPIConfig_detectBodyDrift(rName string, basePath string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name              = %[1]q
+  detect_body_drift = true
+
+  body = jsonencode({
+    swagger = "2.0"
+    info = {
+      title   = "test"
+      version = "2017-04-20T04:08:08Z"
+    }
+    schemes = ["https"]
+    paths = {
+      %[2]q = {
+        get = {
+          responses = {
+            "200" = {
+              description = "OK"
+            }
+          }
+          x-amazon-apigateway-integration = {
+            httpMethod = "GET"
+            type       = "HTTP"
+            responses = {
+              default = {
+                statusCode = 200
+              }
+            }
+            uri = "https://api.example.com/"
+          }
+        }
+      }
+    }
+  })
+}
+`, rName, basePath)
+}
+
 func testAccRestAPIConfig_description(rName string, description string) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -510,47 +509,13 @@ type destinationConfigurationModel struct {
 }
 
 var (
-	_ fwflex.Expander  = destinationConfigurationModel{}
-	_ fwflex.Flattener = &destinationConfigurationModel{}
+	_ fwflex.UnionModel = destinationConfigurationModel{}
 )
 
-func (m destinationConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.AuditLog.IsNull():
-		auditLogDestinationConfigurationData, d := m.AuditLog.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.DestinationConfigurationMemberAuditLog
-		diags.Append(fwflex.Expand(ctx, auditLogDestinationConfigurationData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	}
-
-	return nil, diags
-}
-
-func (m *destinationConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.DestinationConfigurationMemberAuditLog:
-		var model auditLogDestinationConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.AuditLog = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
+func (destinationConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.DestinationConfigurationMemberAuditLog{},
 	}
-
-	return diags
 }
 
 type auditLogDestinationConfigurationModel struct {
@@ -563,74 +528,14 @@ type destinationModel struct {
 }
 
 var (
-	_ fwflex.Expander  = destinationModel{}
-	_ fwflex.Flattener = &destinationModel{}
+	_ fwflex.UnionModel = destinationModel{}
 )
 
-func (m destinationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.FirehoseStream.IsNull():
-		firehoseStreamData, d := m.FirehoseStream.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.DestinationMemberFirehoseStream
-		diags.Append(fwflex.Expand(ctx, firehoseStreamData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-
-	case !m.S3Bucket.IsNull():
-		s3BucketData, d := m.S3Bucket.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.DestinationMemberS3Bucket
-		diags.Append(fwflex.Expand(ctx, s3BucketData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
+func (destinationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.DestinationMemberFirehoseStream{},
+		&awstypes.DestinationMemberS3Bucket{},
 	}
-
-	return nil, diags
-}
-
-func (m *destinationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.DestinationMemberFirehoseStream:
-		var model firehoseStreamModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.FirehoseStream = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-
-	case awstypes.DestinationMemberS3Bucket:
-		var model s3BucketModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.S3Bucket = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	}
-
-	return diags
 }
 
 type firehoseStreamModel struct {
@@ -647,47 +552,13 @@ type processingConfigurationModel struct {
 }
 
 var (
-	_ fwflex.Expander  = processingConfigurationModel{}
-	_ fwflex.Flattener = &processingConfigurationModel{}
+	_ fwflex.UnionModel = processingConfigurationModel{}
 )
 
-func (m processingConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.AuditLog.IsNull():
-		auditLogProcessingConfigurationData, d := m.AuditLog.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.ProcessingConfigurationMemberAuditLog
-		diags.Append(fwflex.Expand(ctx, auditLogProcessingConfigurationData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
+func (processingConfigurationModel) UnionMembers() []any {
+	return []any{
+		&awstypes.ProcessingConfigurationMemberAuditLog{},
 	}
-
-	return nil, diags
-}
-
-func (m *processingConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.ProcessingConfigurationMemberAuditLog:
-		var model auditLogProcessingConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.AuditLog = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-	}
-
-	return diags
 }
 
 type auditLogProcessingConfigurationModel struct {
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package appsync
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestChannelNamespaceResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, channelNamespaceResourceModel{}, []any{
+		appsync.CreateChannelNamespaceInput{},
+		awstypes.ChannelNamespace{},
+		appsync.UpdateChannelNamespaceInput{},
+	})
+}
@@ -317,15 +317,7 @@ func executeAndExpectNoRows(ctx context.Context, conn *athena.Client, qeid strin
 }
 
 func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string) (*types.ResultSet, error) {
-	executionStateConf := &sdkretry.StateChangeConf{
-		Pending:    enum.Slice(types.QueryExecutionStateQueued, types.QueryExecutionStateRunning),
-		Target:     enum.Slice(types.QueryExecutionStateSucceeded),
-		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, qeid),
-		Timeout:    10 * time.Minute,
-		Delay:      3 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err := executionStateConf.WaitForStateContext(ctx)
+	_, err := waitQueryExecutionSucceeded(ctx, conn, qeid, 10*time.Minute)
 
 	if err != nil {
 		return nil, err
@@ -341,6 +333,25 @@ func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string)
 	return resp.ResultSet, nil
 }
 
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Client, id string, timeout time.Duration) (*athena.GetQueryExecutionOutput, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending:    enum.Slice(types.QueryExecutionStateQueued, types.QueryExecutionStateRunning),
+		Target:     enum.Slice(types.QueryExecutionStateSucceeded),
+		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.GetQueryExecutionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func queryExecutionStateRefreshFunc(ctx context.Context, conn *athena.Client, qeid string) sdkretry.StateRefreshFunc {
 	return func() (any, string, error) {
 		input := &athena.GetQueryExecutionInput{
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameQueryResults = "Query Results"
+
+	queryResultsDefaultMaxRows = 1000
+)
+
+// @EphemeralResource("aws_athena_query_results", name="Query Results")
+func newQueryResultsEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &queryResultsEphemeralResource{}, nil
+}
+
+type queryResultsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[queryResultsEphemeralResourceModel]
+}
+
+func (e *queryResultsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Optional: true,
+			},
+			"columns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Optional: true,
+			},
+			"max_rows": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Optional: true,
+			},
+			"query_execution_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+			},
+			"rows": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *queryResultsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data queryResultsEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().AthenaClient(ctx)
+
+	output, err := conn.StartQueryExecution(ctx, data.expand())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Athena, create.ErrActionOpening, ResNameQueryResults, data.QueryString.ValueString(), err), err.Error())
+		return
+	}
+
+	id := aws.ToString(output.QueryExecutionId)
+
+	timeout := 10 * time.Minute
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	if _, err := waitQueryExecutionSucceeded(ctx, conn, id, timeout); err != nil {
+		if retry.TimedOut(err) || ctx.Err() != nil {
+			stopQueryExecution(ctx, conn, id)
+		}
+
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Athena, create.ErrActionWaitingForCreation, ResNameQueryResults, id, err), err.Error())
+		return
+	}
+
+	maxRows := queryResultsDefaultMaxRows
+	if !data.MaxRows.IsNull() {
+		maxRows = int(data.MaxRows.ValueInt64())
+	}
+
+	columns, rows, err := findQueryResultsByID(ctx, conn, id, maxRows)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Athena, create.ErrActionReading, ResNameQueryResults, id, err), err.Error())
+		return
+	}
+
+	data.Columns = fwflex.FlattenFrameworkStringValueListOfString(ctx, columns)
+	data.QueryExecutionID = types.StringValue(id)
+	rowsValue, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, rows)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Rows = rowsValue
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type queryResultsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	queryExecutionModel
+	Columns          fwtypes.ListOfString `tfsdk:"columns"`
+	MaxRows          types.Int64          `tfsdk:"max_rows"`
+	QueryExecutionID types.String         `tfsdk:"query_execution_id"`
+	Rows             types.List           `tfsdk:"rows"`
+	Timeout          types.Int64          `tfsdk:"timeout"`
+}
+
+// findQueryResultsByID returns the column names and up to maxRows rows of a query execution's results.
+// Each row maps column name to value. NULL values are returned as empty strings.
+func findQueryResultsByID(ctx context.Context, conn *athena.Client, id string, maxRows int) ([]string, []map[string]string, error) {
+	input := athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	var columns []string
+	rows := make([]map[string]string, 0)
+
+	pages := athena.NewGetQueryResultsPaginator(conn, &input)
+	for first := true; pages.HasMorePages() && len(rows) < maxRows; first = false {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.ResultSet == nil {
+			break
+		}
+
+		if first && page.ResultSet.ResultSetMetadata != nil {
+			for _, v := range page.ResultSet.ResultSetMetadata.ColumnInfo {
+				columns = append(columns, aws.ToString(v.Name))
+			}
+		}
+
+		for i, v := range page.ResultSet.Rows {
+			// The first row of a SELECT query's results contains the column names.
+			if first && i == 0 && isQueryResultsHeaderRow(v, columns) {
+				continue
+			}
+
+			if len(rows) == maxRows {
+				break
+			}
+
+			row := make(map[string]string, len(columns))
+			for j, datum := range v.Data {
+				if j < len(columns) {
+					row[columns[j]] = aws.ToString(datum.VarCharValue)
+				}
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	return columns, rows, nil
+}
+
+func isQueryResultsHeaderRow(row awstypes.Row, columns []string) bool {
+	if len(row.Data) != len(columns) {
+		return false
+	}
+
+	for i, datum := range row.Data {
+		if aws.ToString(datum.VarCharValue) != columns[i] {
+			return false
+		}
+	}
+
+	return true
+}
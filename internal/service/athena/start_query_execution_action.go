@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queryExecutionPollInterval     = 5 * time.Second
+	queryExecutionProgressInterval = 30 * time.Second
+)
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	queryExecutionModel
+	Timeout           types.Int64 `tfsdk:"timeout"`
+	WaitForCompletion types.Bool  `tfsdk:"wait_for_completion"`
+}
+
+// queryExecutionModel holds the arguments used to start a query execution.
+type queryExecutionModel struct {
+	Catalog        types.String `tfsdk:"catalog"`
+	Database       types.String `tfsdk:"database"`
+	OutputLocation types.String `tfsdk:"output_location"`
+	QueryString    types.String `tfsdk:"query_string"`
+	WorkGroup      types.String `tfsdk:"workgroup"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an Amazon Athena query execution and optionally waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "Name of the data catalog used in the query execution.",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database used in the query execution.",
+				Optional:    true,
+			},
+			"output_location": schema.StringAttribute{
+				Description: "Location in Amazon S3 where query results are stored, e.g. s3://bucket/path/. Required unless the workgroup specifies an output location.",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "SQL query to run.",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query execution to complete. Defaults to 600 seconds (10 minutes).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the query execution to complete. Defaults to true.",
+				Optional:    true,
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query is run. Defaults to primary.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting query execution action", map[string]any{
+		"workgroup":       config.WorkGroup.ValueString(),
+		"timeout_seconds": int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting Athena query execution...",
+	})
+
+	input := config.expand()
+	output, err := conn.StartQueryExecution(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Query Execution",
+			fmt.Sprintf("Could not start Athena query execution: %s", err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.QueryExecutionId)
+
+	if !config.WaitForCompletion.IsNull() && !config.WaitForCompletion.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Query execution %s started", id)})
+		return
+	}
+
+	var lastExecution *awstypes.QueryExecution
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		output, err := findQueryExecutionByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, fmt.Errorf("get query execution: %w", err)
+		}
+		lastExecution = output
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(output.Status.State), Value: output}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(queryExecutionPollInterval),
+		ProgressInterval: queryExecutionProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Query execution %s is currently %s", id, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		if errors.As(err, &timeoutErr) {
+			// Don't leave the query running (and accruing cost) after giving up on it.
+			stopQueryExecution(ctx, conn, id)
+
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution",
+				fmt.Sprintf("Query execution %s did not complete within %v and was stopped", id, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Query Execution Failed",
+				fmt.Sprintf("Query execution %s finished with status %s: %s", id, failureErr.Status, queryExecutionFailureReason(lastExecution)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Query Execution Status",
+				fmt.Sprintf("Query execution %s entered unexpected status: %s", id, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Query Execution",
+				fmt.Sprintf("Error while waiting for query execution %s: %s", id, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Query execution %s completed successfully", id)})
+	tflog.Info(ctx, "Query execution completed successfully", map[string]any{
+		"query_execution_id": id,
+	})
+}
+
+func (m queryExecutionModel) expand() *athena.StartQueryExecutionInput {
+	input := &athena.StartQueryExecutionInput{
+		QueryString: m.QueryString.ValueStringPointer(),
+		WorkGroup:   m.WorkGroup.ValueStringPointer(),
+	}
+
+	if !m.Catalog.IsNull() || !m.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  m.Catalog.ValueStringPointer(),
+			Database: m.Database.ValueStringPointer(),
+		}
+	}
+
+	if !m.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: m.OutputLocation.ValueStringPointer(),
+		}
+	}
+
+	return input
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.InvalidRequestException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.QueryExecution, nil
+}
+
+// queryExecutionFailureReason returns the reasons reported for a failed or cancelled query execution.
+func queryExecutionFailureReason(apiObject *awstypes.QueryExecution) string {
+	if apiObject == nil || apiObject.Status == nil {
+		return "unknown reason"
+	}
+
+	var reasons []string
+
+	if v := aws.ToString(apiObject.Status.StateChangeReason); v != "" {
+		reasons = append(reasons, v)
+	}
+
+	if v := apiObject.Status.AthenaError; v != nil {
+		if v := aws.ToString(v.ErrorMessage); v != "" && !strings.Contains(strings.Join(reasons, ""), v) {
+			reasons = append(reasons, v)
+		}
+	}
+
+	if len(reasons) == 0 {
+		return "unknown reason"
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// stopQueryExecution stops the specified query execution, logging rather than returning any error.
+// The stop is attempted even if ctx has been canceled.
+func stopQueryExecution(ctx context.Context, conn *athena.Client, id string) {
+	input := athena.StopQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+	if _, err := conn.StopQueryExecution(context.WithoutCancel(ctx), &input); err != nil {
+		tflog.Warn(ctx, "Failed to stop query execution", map[string]any{
+			"query_execution_id": id,
+			"error":              err.Error(),
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package auditmanager
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestAssessmentDelegationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, assessmentDelegationResourceModel{}, []any{
+		awstypes.CreateDelegationRequest{},
+		awstypes.DelegationMetadata{},
+	})
+}
@@ -2757,6 +2757,31 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration) (*awstypes.InstanceRefresh, error) {
+	stateConf := &sdkretry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InstanceRefreshStatusBaking,
+			awstypes.InstanceRefreshStatusInProgress,
+			awstypes.InstanceRefreshStatusPending,
+			awstypes.InstanceRefreshStatusRollbackInProgress,
+		),
+		Target:       enum.Slice(awstypes.InstanceRefreshStatusSuccessful),
+		Refresh:      statusInstanceRefresh(ctx, conn, name, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.WarmPoolConfiguration, error) {
 	stateConf := &sdkretry.StateChangeConf{
 		Pending: enum.Slice(awstypes.WarmPoolStatusPendingDelete),
@@ -4152,6 +4177,25 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name s
 	return nil
 }
 
+// rollbackInstanceRefresh starts rolling back the Auto Scaling group's active instance refresh.
+func rollbackInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name string) error {
+	input := autoscaling.RollbackInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	_, err := conn.RollbackInstanceRefresh(ctx, &input)
+
+	if errs.IsA[*awstypes.ActiveInstanceRefreshNotFoundFault](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("rolling back Auto Scaling Group (%s) instance refresh: %w", name, err)
+	}
+
+	return nil
+}
+
 func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) error {
 	name := aws.ToString(input.AutoScalingGroupName)
 
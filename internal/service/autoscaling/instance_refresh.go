@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_autoscaling_instance_refresh", name="Instance Refresh")
+func newInstanceRefreshResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &instanceRefreshResource{}
+
+	r.SetDefaultCreateTimeout(1 * time.Hour)
+
+	return r, nil
+}
+
+type instanceRefreshResource struct {
+	framework.ResourceWithModel[instanceRefreshResourceModel]
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *instanceRefreshResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cancel_on_failure": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("rollback_on_failure")),
+				},
+			},
+			"instance_refresh_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rollback_on_failure": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTriggers: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[refreshPreferencesModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Optional: true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+							},
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"skip_matching": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *instanceRefreshResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data instanceRefreshResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AutoScalingClient(ctx)
+
+	name := data.AutoScalingGroupName.ValueString()
+	preferences, diags := data.Preferences.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := startInstanceRefreshInputFromModel(ctx, conn, name, preferences, data.RollbackOnFailure.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Auto Scaling Group (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Auto Scaling Group (%s) instance refresh", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+	data.InstanceRefreshID = fwflex.StringValueToFramework(ctx, id)
+
+	if data.WaitForCompletion.IsNull() || data.WaitForCompletion.ValueBool() {
+		if _, err := waitInstanceRefreshSuccessful(ctx, conn, name, id, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			// A refresh in a failure state has already finished; a refresh that timed out is still running.
+			if retry.TimedOut(err) {
+				switch {
+				case data.CancelOnFailure.ValueBool():
+					if err := cancelInstanceRefresh(ctx, conn, name); err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("cancelling Auto Scaling Group (%s) instance refresh (%s)", name, id), err.Error())
+					}
+				case data.RollbackOnFailure.ValueBool():
+					if err := rollbackInstanceRefresh(ctx, conn, name); err != nil {
+						response.Diagnostics.AddError(fmt.Sprintf("rolling back Auto Scaling Group (%s) instance refresh (%s)", name, id), err.Error())
+					}
+				}
+			}
+
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Auto Scaling Group (%s) instance refresh (%s)", name, id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+type instanceRefreshResourceModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                             `tfsdk:"autoscaling_group_name"`
+	CancelOnFailure      types.Bool                                               `tfsdk:"cancel_on_failure"`
+	InstanceRefreshID    types.String                                             `tfsdk:"instance_refresh_id"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesModel] `tfsdk:"preferences"`
+	RollbackOnFailure    types.Bool                                               `tfsdk:"rollback_on_failure"`
+	Timeouts             timeouts.Value                                           `tfsdk:"timeouts"`
+	Triggers             fwtypes.MapOfString                                      `tfsdk:"triggers"`
+	WaitForCompletion    types.Bool                                               `tfsdk:"wait_for_completion"`
+}
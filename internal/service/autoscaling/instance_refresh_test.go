@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingInstanceRefresh_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	groupResourceName := "aws_autoscaling_group.test"
+	resourceName := "aws_autoscaling_instance_refresh.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, groupResourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_refresh_id"),
+				),
+			},
+			{
+				Config: testAccInstanceRefreshConfig_basic(rName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, groupResourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 2),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingInstanceRefresh_rollbackOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	groupResourceName := "aws_autoscaling_group.test"
+	resourceName := "aws_autoscaling_instance_refresh.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceRefreshConfig_rollbackOnFailure(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, groupResourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceRefreshConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplate(rName), fmt.Sprintf(`
+resource "aws_autoscaling_instance_refresh" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  triggers = {
+    refresh = %[1]q
+  }
+}
+`, trigger))
+}
+
+func testAccInstanceRefreshConfig_rollbackOnFailure(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplate(rName), `
+resource "aws_autoscaling_instance_refresh" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+  rollback_on_failure    = true
+
+  preferences {
+    min_healthy_percentage = 50
+  }
+}
+`)
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	instanceRefreshPollInterval     = 30 * time.Second
+	instanceRefreshProgressInterval = 1 * time.Minute
+)
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                             `tfsdk:"autoscaling_group_name"`
+	CancelOnFailure      types.Bool                                               `tfsdk:"cancel_on_failure"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesModel] `tfsdk:"preferences"`
+	RollbackOnFailure    types.Bool                                               `tfsdk:"rollback_on_failure"`
+	Timeout              types.Int64                                              `tfsdk:"timeout"`
+	WaitForCompletion    types.Bool                                               `tfsdk:"wait_for_completion"`
+}
+
+type refreshPreferencesModel struct {
+	AutoRollback          types.Bool          `tfsdk:"auto_rollback"`
+	CheckpointDelay       types.Int64         `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages fwtypes.ListOfInt64 `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup        types.Int64         `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage  types.Int64         `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage  types.Int64         `tfsdk:"min_healthy_percentage"`
+	SkipMatching          types.Bool          `tfsdk:"skip_matching"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and optionally waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "Name of the Auto Scaling group.",
+				Required:    true,
+			},
+			"cancel_on_failure": schema.BoolAttribute{
+				Description: "Whether to cancel the instance refresh if it does not complete within the timeout. An instance refresh that fails has already stopped and is not cancelled. Defaults to false.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("rollback_on_failure")),
+				},
+			},
+			"rollback_on_failure": schema.BoolAttribute{
+				Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails or does not complete within the timeout. Implies preferences.auto_rollback. Defaults to false.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete. Defaults to 3600 seconds (1 hour).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the instance refresh to complete. Defaults to true.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[refreshPreferencesModel](ctx),
+				Description: "Preferences for the instance refresh.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails.",
+							Optional:    true,
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "Number of seconds to wait after a checkpoint.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							ElementType: types.Int64Type,
+							Description: "Percentages of replaced instances at which to pause the instance refresh, in ascending order.",
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+							},
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "Number of seconds until a newly launched instance is configured and ready to use.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "Maximum percentage of the group's desired capacity that can be in service and healthy, or pending, during the instance refresh.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "Minimum percentage of the group's desired capacity that must remain healthy during the instance refresh.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the desired configuration.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := config.AutoScalingGroupName.ValueString()
+
+	timeout := 1 * time.Hour
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		"timeout_seconds":        int64(timeout.Seconds()),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting instance refresh of Auto Scaling group %s...", name),
+	})
+
+	preferences, diags := config.Preferences.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := startInstanceRefreshInputFromModel(ctx, conn, name, preferences, config.RollbackOnFailure.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not read Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not start instance refresh of Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+
+	if !config.WaitForCompletion.IsNull() && !config.WaitForCompletion.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Instance refresh %s of Auto Scaling group %s started", id, name)})
+		return
+	}
+
+	var lastRefresh *awstypes.InstanceRefresh
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		output, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, fmt.Errorf("describe instance refresh: %w", err)
+		}
+		lastRefresh = output
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(instanceRefreshPollInterval),
+		ProgressInterval: instanceRefreshProgressInterval,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusSuccessful),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Instance refresh %s is currently %s", id, fr.Status)
+			if v, ok := fr.Value.(*awstypes.InstanceRefresh); ok && v.PercentageComplete != nil {
+				message = fmt.Sprintf("Instance refresh %s is currently %s (%d%% complete)", id, fr.Status, aws.ToInt32(v.PercentageComplete))
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+
+		// A refresh in a failure state has already finished (and if rollback_on_failure is set,
+		// been rolled back automatically); any other error leaves it running.
+		if !errors.As(err, &failureErr) {
+			switch {
+			case config.CancelOnFailure.ValueBool():
+				resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Cancelling instance refresh %s...", id)})
+				if err := cancelInstanceRefresh(ctx, conn, name); err != nil {
+					resp.Diagnostics.AddError("Failed to Cancel Instance Refresh", err.Error())
+				}
+			case config.RollbackOnFailure.ValueBool():
+				resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Rolling back instance refresh %s...", id)})
+				if err := rollbackInstanceRefresh(ctx, conn, name); err != nil {
+					resp.Diagnostics.AddError("Failed to Roll Back Instance Refresh", err.Error())
+				}
+			}
+		}
+
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s did not complete within %v", id, name, timeout),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := fmt.Sprintf("Instance refresh %s of Auto Scaling group %s finished with status: %s", id, name, failureErr.Status)
+			if lastRefresh != nil && lastRefresh.StatusReason != nil {
+				message = fmt.Sprintf("%s (%s)", message, aws.ToString(lastRefresh.StatusReason))
+			}
+			resp.Diagnostics.AddError("Instance Refresh Failed", message)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh Status",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s entered unexpected status: %s", id, name, unexpectedErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for instance refresh %s of Auto Scaling group %s: %s", id, name, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Instance refresh %s of Auto Scaling group %s completed successfully", id, name)})
+	tflog.Info(ctx, "Instance refresh completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
+
+// startInstanceRefreshInputFromModel returns the input to start a rolling instance refresh of the Auto Scaling group `name`.
+// If rollbackOnFailure is true, the instance refresh is rolled back automatically if it fails.
+func startInstanceRefreshInputFromModel(ctx context.Context, conn *autoscaling.Client, name string, preferences *refreshPreferencesModel, rollbackOnFailure bool) (*autoscaling.StartInstanceRefreshInput, error) {
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             awstypes.RefreshStrategyRolling,
+	}
+
+	if preferences != nil {
+		input.Preferences = expandRefreshPreferencesModel(preferences)
+	}
+
+	if rollbackOnFailure {
+		if input.Preferences == nil {
+			input.Preferences = &awstypes.RefreshPreferences{}
+		}
+		input.Preferences.AutoRollback = aws.Bool(true)
+	}
+
+	// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+	if input.Preferences != nil && aws.ToBool(input.Preferences.AutoRollback) {
+		group, err := findGroupByName(ctx, conn, name)
+		if err != nil {
+			return nil, err
+		}
+
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{
+			LaunchTemplate:       group.LaunchTemplate,
+			MixedInstancesPolicy: group.MixedInstancesPolicy,
+		}
+	}
+
+	return input, nil
+}
+
+func expandRefreshPreferencesModel(data *refreshPreferencesModel) *awstypes.RefreshPreferences {
+	apiObject := &awstypes.RefreshPreferences{
+		AutoRollback: data.AutoRollback.ValueBoolPointer(),
+		SkipMatching: data.SkipMatching.ValueBoolPointer(),
+	}
+
+	if !data.CheckpointDelay.IsNull() {
+		apiObject.CheckpointDelay = aws.Int32(int32(data.CheckpointDelay.ValueInt64()))
+	}
+
+	for _, v := range data.CheckpointPercentages.Elements() {
+		apiObject.CheckpointPercentages = append(apiObject.CheckpointPercentages, int32(v.(types.Int64).ValueInt64()))
+	}
+
+	if !data.InstanceWarmup.IsNull() {
+		apiObject.InstanceWarmup = aws.Int32(int32(data.InstanceWarmup.ValueInt64()))
+	}
+
+	if !data.MaxHealthyPercentage.IsNull() {
+		apiObject.MaxHealthyPercentage = aws.Int32(int32(data.MaxHealthyPercentage.ValueInt64()))
+	}
+
+	if !data.MinHealthyPercentage.IsNull() {
+		apiObject.MinHealthyPercentage = aws.Int32(int32(data.MinHealthyPercentage.ValueInt64()))
+	}
+
+	return apiObject
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bcmdataexports
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestExportResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, exportResourceModel{}, []any{
+		bcmdataexports.CreateExportInput{},
+		bcmdataexports.GetExportOutput{},
+		bcmdataexports.UpdateExportInput{},
+		bcmdataexports.UpdateExportOutput{},
+	})
+}
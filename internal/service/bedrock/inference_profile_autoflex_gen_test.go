@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrock
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestInferenceProfileResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, inferenceProfileResourceModel{}, []any{
+		bedrock.CreateInferenceProfileInput{},
+		bedrock.GetInferenceProfileOutput{},
+	}, flex.WithFieldNamePrefix("InferenceProfile"))
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagent
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestFlowResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, flowResourceModel{}, []any{
+		bedrockagent.CreateFlowInput{},
+		bedrockagent.CreateFlowOutput{},
+		bedrockagent.GetFlowOutput{},
+		bedrockagent.UpdateFlowInput{},
+		bedrockagent.UpdateFlowOutput{},
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagentcore
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestAgentRuntimeEndpointResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, agentRuntimeEndpointResourceModel{}, []any{
+		bedrockagentcorecontrol.CreateAgentRuntimeEndpointInput{},
+		bedrockagentcorecontrol.GetAgentRuntimeEndpointOutput{},
+	}, fwflex.WithFieldNamePrefix("AgentRuntimeEndpoint"))
+	autoflex.CheckFieldMappings(t, agentRuntimeEndpointResourceModel{}, []any{
+		bedrockagentcorecontrol.UpdateAgentRuntimeEndpointInput{},
+	}, fwflex.WithFieldNamePrefix("Endpoint"))
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagentcore
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestApiKeyCredentialProviderResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, apiKeyCredentialProviderResourceModel{}, []any{
+		bedrockagentcorecontrol.CreateApiKeyCredentialProviderInput{},
+		bedrockagentcorecontrol.CreateApiKeyCredentialProviderOutput{},
+		bedrockagentcorecontrol.GetApiKeyCredentialProviderOutput{},
+		bedrockagentcorecontrol.UpdateApiKeyCredentialProviderInput{},
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagentcore
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestMemoryResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, memoryResourceModel{}, []any{
+		bedrockagentcorecontrol.CreateMemoryInput{},
+		bedrockagentcorecontrol.UpdateMemoryInput{},
+	})
+	autoflex.CheckFieldMappings(t, memoryResourceModel{}, []any{
+		awstypes.Memory{},
+	}, fwflex.WithFieldNamePrefix("Memory"))
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagentcore
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestMemoryStrategyResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, memoryStrategyResourceModel{}, []any{
+		awstypes.MemoryStrategy{},
+	}, fwflex.WithFieldNamePrefix("Memory"))
+	autoflex.CheckFieldMappings(t, memoryStrategyResourceModel{}, []any{
+		awstypes.ModifyMemoryStrategyInput{},
+	})
+}
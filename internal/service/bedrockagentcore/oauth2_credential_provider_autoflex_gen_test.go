@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package bedrockagentcore
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagentcorecontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestOauth2CredentialProviderResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, oauth2CredentialProviderResourceModel{}, []any{
+		bedrockagentcorecontrol.CreateOauth2CredentialProviderInput{},
+		bedrockagentcorecontrol.UpdateOauth2CredentialProviderInput{},
+	}, fwflex.WithFieldNameSuffix("Input"))
+	autoflex.CheckFieldMappings(t, oauth2CredentialProviderResourceModel{}, []any{
+		bedrockagentcorecontrol.GetOauth2CredentialProviderOutput{},
+	}, fwflex.WithFieldNameSuffix("Output"))
+}
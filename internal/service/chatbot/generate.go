@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsSlice -KVTValues -TagOp=TagResource -TagInIDElem=ResourceARN -UntagOp=UntagResource -UpdateTags -TagTypeKeyElem=TagKey -TagTypeValElem=TagValue
+//go:generate go run ../../generate/autoflextests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package chatbot
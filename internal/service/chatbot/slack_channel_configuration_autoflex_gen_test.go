@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package chatbot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestSlackChannelConfigurationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, slackChannelConfigurationResourceModel{}, []any{
+		chatbot.CreateSlackChannelConfigurationInput{},
+		awstypes.SlackChannelConfiguration{},
+		chatbot.UpdateSlackChannelConfigurationInput{},
+	})
+}
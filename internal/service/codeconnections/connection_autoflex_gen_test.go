@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package codeconnections
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestConnectionResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, connectionResourceModel{}, []any{
+		codeconnections.CreateConnectionInput{},
+	}, fwflex.WithFieldNamePrefix("Connection"))
+	autoflex.CheckFieldMappings(t, connectionResourceModel{}, []any{
+		awstypes.Connection{},
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package codeguruprofiler
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codeguruprofiler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeguruprofiler/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestProfilingGroupResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, profilingGroupResourceModel{}, []any{
+		codeguruprofiler.CreateProfilingGroupInput{},
+		awstypes.ProfilingGroupDescription{},
+		codeguruprofiler.UpdateProfilingGroupInput{},
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package computeoptimizer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestRecommendationPreferencesResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, recommendationPreferencesResourceModel{}, []any{
+		computeoptimizer.PutRecommendationPreferencesInput{},
+		awstypes.RecommendationPreferencesDetail{},
+		computeoptimizer.DeleteRecommendationPreferencesInput{},
+	})
+}
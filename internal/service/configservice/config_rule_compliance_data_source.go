@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_config_config_rule_compliance", name="Config Rule Compliance")
+func dataSourceConfigRuleCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigRuleComplianceRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"compliance_type_counts": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+				},
+				"compliance_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[types.ComplianceType](),
+					},
+				},
+				"evaluation_results": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"annotation": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"compliance_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"config_rule_invoked_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrResourceID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrResourceType: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"result_recorded_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrResourceID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{names.AttrResourceType},
+				},
+				names.AttrResourceType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{names.AttrResourceID},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceConfigRuleComplianceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	var complianceTypes []types.ComplianceType
+	if v, ok := d.GetOk("compliance_types"); ok && v.(*schema.Set).Len() > 0 {
+		complianceTypes = flex.ExpandStringyValueSet[types.ComplianceType](v.(*schema.Set))
+	}
+
+	var results []types.EvaluationResult
+	var err error
+	if resourceID, resourceType := d.Get(names.AttrResourceID).(string), d.Get(names.AttrResourceType).(string); resourceID != "" && resourceType != "" {
+		input := &configservice.GetComplianceDetailsByResourceInput{
+			ComplianceTypes: complianceTypes,
+			ResourceId:      aws.String(resourceID),
+			ResourceType:    aws.String(resourceType),
+		}
+
+		results, err = findComplianceDetailsByResourceAndConfigRule(ctx, conn, input, name)
+	} else {
+		input := &configservice.GetComplianceDetailsByConfigRuleInput{
+			ComplianceTypes: complianceTypes,
+			ConfigRuleName:  aws.String(name),
+		}
+
+		results, err = findComplianceDetailsByConfigRule(ctx, conn, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ConfigService Config Rule Compliance", err))
+	}
+
+	complianceTypeCounts := make(map[string]any)
+	for _, v := range results {
+		complianceType := string(v.ComplianceType)
+		if n, ok := complianceTypeCounts[complianceType].(int); ok {
+			complianceTypeCounts[complianceType] = n + 1
+		} else {
+			complianceTypeCounts[complianceType] = 1
+		}
+	}
+
+	d.SetId(name)
+	d.Set("compliance_type_counts", complianceTypeCounts)
+	if err := d.Set("evaluation_results", flattenEvaluationResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting evaluation_results: %s", err)
+	}
+
+	return diags
+}
+
+func findComplianceDetailsByConfigRule(ctx context.Context, conn *configservice.Client, input *configservice.GetComplianceDetailsByConfigRuleInput) ([]types.EvaluationResult, error) {
+	var output []types.EvaluationResult
+
+	pages := configservice.NewGetComplianceDetailsByConfigRulePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NoSuchConfigRuleException](err) {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EvaluationResults...)
+	}
+
+	return output, nil
+}
+
+func findComplianceDetailsByResourceAndConfigRule(ctx context.Context, conn *configservice.Client, input *configservice.GetComplianceDetailsByResourceInput, configRuleName string) ([]types.EvaluationResult, error) {
+	var output []types.EvaluationResult
+
+	pages := configservice.NewGetComplianceDetailsByResourcePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EvaluationResults {
+			if id := v.EvaluationResultIdentifier; id != nil && id.EvaluationResultQualifier != nil && aws.ToString(id.EvaluationResultQualifier.ConfigRuleName) == configRuleName {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenEvaluationResults(apiObjects []types.EvaluationResult) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"annotation":               aws.ToString(apiObject.Annotation),
+			"compliance_type":          string(apiObject.ComplianceType),
+			"config_rule_invoked_time": aws.ToTime(apiObject.ConfigRuleInvokedTime).Format(time.RFC3339),
+			"result_recorded_time":     aws.ToTime(apiObject.ResultRecordedTime).Format(time.RFC3339),
+		}
+
+		if v := apiObject.EvaluationResultIdentifier; v != nil && v.EvaluationResultQualifier != nil {
+			tfMap[names.AttrResourceID] = aws.ToString(v.EvaluationResultQualifier.ResourceId)
+			tfMap[names.AttrResourceType] = aws.ToString(v.EvaluationResultQualifier.ResourceType)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
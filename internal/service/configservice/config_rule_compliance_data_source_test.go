@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigRuleComplianceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_config_rule_compliance.test"
+	resourceName := "aws_config_config_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRuleComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evaluation_results.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_type_counts.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRuleComplianceDataSource_resource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_config_rule_compliance.test"
+	resourceName := "aws_config_config_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRuleComplianceDataSourceConfig_resource(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, "AWS::S3::Bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrResourceID, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evaluation_results.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRuleComplianceDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), `
+data "aws_config_config_rule_compliance" "test" {
+  name             = aws_config_config_rule.test.name
+  compliance_types = ["COMPLIANT", "NON_COMPLIANT"]
+}
+`)
+}
+
+func testAccConfigRuleComplianceDataSourceConfig_resource(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_config_config_rule_compliance" "test" {
+  name          = aws_config_config_rule.test.name
+  resource_type = "AWS::S3::Bucket"
+  resource_id   = aws_s3_bucket.test.bucket
+}
+`, rName))
+}
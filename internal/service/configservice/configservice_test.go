@@ -32,6 +32,10 @@ func TestAccConfigService_serial(t *testing.T) {
 			"tags":               testAccConfigServiceConfigRule_tagsSerial,
 			acctest.CtDisappears: testAccConfigRule_disappears,
 		},
+		"ConfigRuleComplianceDataSource": {
+			acctest.CtBasic: testAccConfigRuleComplianceDataSource_basic,
+			"resource":      testAccConfigRuleComplianceDataSource_resource,
+		},
 		"ConfigurationRecorderStatus": {
 			acctest.CtBasic:      testAccConfigurationRecorderStatus_basic,
 			"startEnabled":       testAccConfigurationRecorderStatus_startEnabled,
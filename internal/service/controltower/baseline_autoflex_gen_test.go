@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package controltower
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestResourceBaselineDataAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, resourceBaselineData{}, []any{
+		controltower.EnableBaselineInput{},
+		controltower.EnableBaselineOutput{},
+		awstypes.EnabledBaselineDetails{},
+		controltower.UpdateEnabledBaselineInput{},
+		controltower.UpdateEnabledBaselineOutput{},
+	})
+}
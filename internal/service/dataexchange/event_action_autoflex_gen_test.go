@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package dataexchange
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestEventActionResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, eventActionResourceModel{}, []any{
+		dataexchange.CreateEventActionInput{},
+		dataexchange.CreateEventActionOutput{},
+		dataexchange.GetEventActionOutput{},
+		dataexchange.UpdateEventActionOutput{},
+	})
+	autoflex.CheckFieldMappings(t, eventActionResourceModel{}, []any{
+		dataexchange.UpdateEventActionInput{},
+	}, flex.WithFieldNamePrefix("EventAction"))
+}
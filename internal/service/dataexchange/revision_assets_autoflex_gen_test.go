@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package dataexchange
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dataexchange"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestRevisionAssetsResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, revisionAssetsResourceModel{}, []any{
+		dataexchange.CreateRevisionInput{},
+		dataexchange.CreateRevisionOutput{},
+		dataexchange.GetRevisionOutput{},
+		dataexchange.UpdateRevisionInput{},
+		dataexchange.UpdateRevisionOutput{},
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package datazone
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestGlossaryResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, glossaryResourceModel{}, []any{
+		datazone.CreateGlossaryInput{},
+		datazone.CreateGlossaryOutput{},
+		datazone.GetGlossaryOutput{},
+		datazone.UpdateGlossaryInput{},
+		datazone.UpdateGlossaryOutput{},
+	})
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package datazone
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestGlossaryTermResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, glossaryTermResourceModel{}, []any{
+		datazone.CreateGlossaryTermInput{},
+		datazone.CreateGlossaryTermOutput{},
+		datazone.GetGlossaryTermOutput{},
+		datazone.UpdateGlossaryTermInput{},
+		datazone.UpdateGlossaryTermOutput{},
+	})
+}
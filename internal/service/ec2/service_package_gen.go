@@ -267,6 +267,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newSecurityGroupRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newSecurityGroupVPCAssociationResource,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -1319,6 +1325,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: names.AttrID,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			AssumeRole: inttypes.ServicePackageResourceAssumeRole{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourceEgressOnlyInternetGateway,
@@ -1812,6 +1821,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: names.AttrID,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			AssumeRole: inttypes.ServicePackageResourceAssumeRole{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourceVPCPeeringConnectionOptions,
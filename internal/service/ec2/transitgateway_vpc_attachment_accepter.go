@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_vpc_attachment_accepter", name="Transit Gateway VPC Attachment Accepter")
+// @AssumeRoleOverride
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
 func resourceTransitGatewayVPCAttachmentAccepter() *schema.Resource {
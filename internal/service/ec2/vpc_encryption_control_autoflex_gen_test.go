@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestResourceVPCEncryptionControlModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, resourceVPCEncryptionControlModel{}, []any{
+		ec2.CreateVpcEncryptionControlInput{},
+		awstypes.VpcEncryptionControl{},
+	})
+	autoflex.CheckFieldMappings(t, resourceVPCEncryptionControlModel{}, []any{
+		awstypes.VpcEncryptionControl{},
+	}, flex.WithFieldNamePrefix("VpcEncryptionControl"))
+}
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_peering_connection_accepter", name="VPC Peering Connection")
+// @AssumeRoleOverride
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
 func resourceVPCPeeringConnectionAccepter() *schema.Resource {
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestCapabilityResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, capabilityResourceModel{}, []any{
+		eks.CreateCapabilityInput{},
+		awstypes.Capability{},
+	})
+	autoflex.CheckFieldMappings(t, capabilityResourceModel{}, []any{
+		eks.UpdateCapabilityInput{},
+	}, fwflex.WithIgnoredFieldNamesAppend("RbacRoleMappings"))
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestPodIdentityAssociationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, podIdentityAssociationResourceModel{}, []any{
+		eks.CreatePodIdentityAssociationInput{},
+		awstypes.PodIdentityAssociation{},
+		eks.UpdatePodIdentityAssociationInput{},
+		eks.DeletePodIdentityAssociationInput{},
+	})
+}
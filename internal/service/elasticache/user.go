@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -99,10 +100,19 @@ func resourceUser() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(16, 128),
+				ConflictsWith: []string{"passwords"},
+			},
 			"passwords": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 2,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      2,
+				ConflictsWith: []string{"password_wo"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
@@ -121,7 +131,10 @@ func resourceUser() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			flex.AttrWriteOnlyVersion: flex.WriteOnlyVersionSchema(),
 		},
+
+		CustomizeDiff: flex.WriteOnlyValuesCustomizeDiff(cty.GetAttrPath("password_wo")),
 	}
 }
 
@@ -148,6 +161,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		input.Passwords = []string{passwordWO}
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -167,6 +190,11 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache User (%s) create: %s", d.Id(), err)
 	}
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, cty.GetAttrPath("password_wo"))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
 		err := createTags(ctx, conn, aws.ToString(output.ARN), tags)
@@ -228,7 +256,16 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Removing the write-only password leaves the current passwords unchanged.
+	hasPasswordWOChange := passwordWO != "" && flex.HasWriteOnlyValueChange(ctx, d, cty.GetAttrPath("password_wo"))
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, flex.AttrWriteOnlyVersion) || hasPasswordWOChange {
 		input := &elasticache.ModifyUserInput{
 			UserId: aws.String(d.Id()),
 		}
@@ -255,6 +292,10 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if hasPasswordWOChange {
+			input.Passwords = []string{passwordWO}
+		}
+
 		_, err := conn.ModifyUser(ctx, input)
 
 		if err != nil {
@@ -266,6 +307,11 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, cty.GetAttrPath("password_wo"))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
@@ -532,6 +532,26 @@ func resourceListenerRule() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: validListenerRulePriority,
 			},
+			"priority_range": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{names.AttrPriority},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMax: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(listenerRulePriorityMin, listenerRulePriorityMax),
+						},
+						names.AttrMin: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(listenerRulePriorityMin, listenerRulePriorityMax),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"transform": {
@@ -572,6 +592,7 @@ func resourceListenerRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			validateListenerActionsCustomDiff(names.AttrAction),
+			listenerRulePriorityRangeCustomizeDiff,
 		),
 	}
 }
@@ -768,7 +789,13 @@ func resourceListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
-		if d.HasChange(names.AttrPriority) {
+		if o, _ := d.GetChange(names.AttrPriority); d.HasChange("priority_range") && !listenerRulePriorityInRange(o.(int), d.Get("priority_range").([]any)) {
+			minPriority, maxPriority := expandListenerRulePriorityRange(d.Get("priority_range").([]any))
+
+			if err := setListenerRulePriorityInRange(ctx, conn, d.Id(), minPriority, maxPriority); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ELB v2 Listener Rule (%s): setting priority: %s", d.Id(), err)
+			}
+		} else if d.HasChange(names.AttrPriority) {
 			input := &elasticloadbalancingv2.SetRulePrioritiesInput{
 				RulePriorities: []awstypes.RulePriorityPair{
 					{
@@ -861,13 +888,29 @@ func retryListenerRuleCreate(ctx context.Context, conn *elasticloadbalancingv2.C
 	const (
 		timeout = 5 * time.Minute
 	)
-	outputRaw, err := tfresource.RetryWhenIsA[any, *awstypes.PriorityInUseException](ctx, timeout, func(ctx context.Context) (any, error) {
+	nextPriority := func(ctx context.Context) (int32, error) {
 		priority, err := highestListenerRulePriority(ctx, conn, listenerARN)
+		if err != nil {
+			return 0, err
+		}
+
+		return priority + 1, nil
+	}
+
+	if v, ok := d.GetOk("priority_range"); ok {
+		minPriority, maxPriority := expandListenerRulePriorityRange(v.([]any))
+		nextPriority = func(ctx context.Context) (int32, error) {
+			return lowestFreeListenerRulePriority(ctx, conn, listenerARN, minPriority, maxPriority)
+		}
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsA[any, *awstypes.PriorityInUseException](ctx, timeout, func(ctx context.Context) (any, error) {
+		priority, err := nextPriority(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		input.Priority = aws.Int32(priority + 1)
+		input.Priority = aws.Int32(priority)
 		return conn.CreateRule(ctx, input)
 	})
 
@@ -955,6 +998,99 @@ func highestListenerRulePriority(ctx context.Context, conn *elasticloadbalancing
 	return slices.Max(priorities), nil
 }
 
+// lowestFreeListenerRulePriority returns the lowest priority in the range [minPriority, maxPriority] that isn't used by a rule on the listener.
+func lowestFreeListenerRulePriority(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string, minPriority, maxPriority int32) (int32, error) {
+	input := &elasticloadbalancingv2.DescribeRulesInput{
+		ListenerArn: aws.String(arn),
+	}
+	rules, err := findListenerRules(ctx, conn, input, func(v *awstypes.Rule) bool {
+		return aws.ToString(v.Priority) != "default"
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	priorities := tfslices.ApplyToAll(rules, func(v awstypes.Rule) int32 {
+		return flex.StringToInt32Value(v.Priority)
+	})
+
+	for priority := minPriority; priority <= maxPriority; priority++ {
+		if !slices.Contains(priorities, priority) {
+			return priority, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no free priority in range %d-%d on ELBv2 Listener (%s)", minPriority, maxPriority, arn)
+}
+
+func setListenerRulePriorityInRange(ctx context.Context, conn *elasticloadbalancingv2.Client, ruleARN string, minPriority, maxPriority int32) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	listenerARN := listenerARNFromRuleARN(ruleARN)
+	_, err := tfresource.RetryWhenIsA[any, *awstypes.PriorityInUseException](ctx, timeout, func(ctx context.Context) (any, error) {
+		priority, err := lowestFreeListenerRulePriority(ctx, conn, listenerARN, minPriority, maxPriority)
+		if err != nil {
+			return nil, err
+		}
+
+		input := &elasticloadbalancingv2.SetRulePrioritiesInput{
+			RulePriorities: []awstypes.RulePriorityPair{
+				{
+					RuleArn:  aws.String(ruleARN),
+					Priority: aws.Int32(priority),
+				},
+			},
+		}
+
+		return conn.SetRulePriorities(ctx, input)
+	})
+
+	return err
+}
+
+func expandListenerRulePriorityRange(tfList []any) (int32, int32) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return listenerRulePriorityMin, listenerRulePriorityMax
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	return int32(tfMap[names.AttrMin].(int)), int32(tfMap[names.AttrMax].(int))
+}
+
+// listenerRulePriorityInRange returns whether the priority lies in the configured priority range.
+// Any priority is in range if no range is configured.
+func listenerRulePriorityInRange(priority int, tfList []any) bool {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return true
+	}
+
+	minPriority, maxPriority := expandListenerRulePriorityRange(tfList)
+
+	return int32(priority) >= minPriority && int32(priority) <= maxPriority
+}
+
+func listenerRulePriorityRangeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	v, ok := d.GetOk("priority_range")
+	if !ok {
+		return nil
+	}
+
+	tfList := v.([]any)
+	if minPriority, maxPriority := expandListenerRulePriorityRange(tfList); minPriority > maxPriority {
+		return fmt.Errorf("priority_range: min (%d) must be less than or equal to max (%d)", minPriority, maxPriority)
+	}
+
+	// A rule whose priority falls outside a changed range is moved into it.
+	if d.Id() != "" && d.HasChange("priority_range") && !listenerRulePriorityInRange(d.Get(names.AttrPriority).(int), tfList) {
+		return d.SetNewComputed(names.AttrPriority)
+	}
+
+	return nil
+}
+
 func validListenerRulePriority(v any, k string) (ws []string, errors []error) {
 	value := v.(int)
 	if value < listenerRulePriorityMin || (value > listenerRulePriorityMax && value != listenerRulePriorityDefault) {
@@ -1186,6 +1186,48 @@ func TestAccELBV2ListenerRule_priority(t *testing.T) {
 	})
 }
 
+func TestAccELBV2ListenerRule_priorityRange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rule.test"
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_priorityRange(rName, 100, 199),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "101"),
+					resource.TestCheckResourceAttr(resourceName, "priority_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "priority_range.0.min", "100"),
+					resource.TestCheckResourceAttr(resourceName, "priority_range.0.max", "199"),
+				),
+			},
+			{
+				Config: testAccListenerRuleConfig_priorityRange(rName, 200, 299),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, resourceName, &after),
+					testAccCheckListenerRuleNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "200"),
+				),
+			},
+			{
+				Config:      testAccListenerRuleConfig_priorityRange(rName, 300, 200),
+				ExpectError: regexache.MustCompile(`must be less than or equal to max`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2ListenerRule_cognito(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Rule
@@ -3814,9 +3856,53 @@ resource "aws_lb_listener_rule" "parallelism" {
     Name = %[1]q
   }
 }
+
 `, rName))
 }
 
+func testAccListenerRuleConfig_priorityRange(rName string, minPriority, maxPriority int) string {
+	return acctest.ConfigCompose(
+		testAccListenerRuleConfig_baseWithHTTPListener(rName), fmt.Sprintf(`
+resource "aws_lb_listener_rule" "fixed" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 100
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/fixed/*"]
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  priority_range {
+    min = %[2]d
+    max = %[3]d
+  }
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/ranged/*"]
+    }
+  }
+
+  depends_on = [aws_lb_listener_rule.fixed]
+}
+`, rName, minPriority, maxPriority))
+}
+
 func testAccListenerRuleConfig_priority50000(rName string) string {
 	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithHTTPListener(rName), fmt.Sprintf(`
 resource "aws_lb_listener_rule" "priority50000" {
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_lb_listener_rules", name="Listener Rules")
+func newListenerRulesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &listenerRulesDataSource{}, nil
+}
+
+const (
+	dsNameListenerRules = "Listener Rules Data Source"
+)
+
+type listenerRulesDataSource struct {
+	framework.DataSourceWithModel[listenerRulesDataSourceModel]
+}
+
+func (d *listenerRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"listener_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"priorities": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[listenerRulesRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Computed:   true,
+						},
+						"is_default": schema.BoolAttribute{
+							Computed: true,
+						},
+						names.AttrPriority: schema.Int32Attribute{
+							Computed: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAction:    listenerRuleActionDataSourceBlock(ctx),
+						names.AttrCondition: listenerRuleConditionDataSourceBlock(ctx),
+						"transform":         listenerRuleTransformDataSourceBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *listenerRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ELBV2Client(ctx)
+
+	var data listenerRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listenerARN := data.ListenerARN.ValueString()
+	input := &elasticloadbalancingv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	out, err := findListenerRules(ctx, conn, input, tfslices.PredicateTrue[*awstypes.Rule]())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ELBV2, create.ErrActionReading, dsNameListenerRules, listenerARN, err),
+			err.Error(),
+		)
+		return
+	}
+
+	rules := make([]listenerRulesRuleModel, 0, len(out))
+	priorities := make(map[string]string, len(out))
+	for _, v := range out {
+		sortListenerActions(v.Actions)
+
+		var rule listenerRulesRuleModel
+		resp.Diagnostics.Append(flex.Flatten(ctx, &v, &rule, flex.WithFieldNamePrefix("Rule"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Rules are evaluated in priority order, from the lowest value to the highest value. The default rule has the lowest priority.
+		if p := aws.ToString(v.Priority); p == "default" {
+			rule.Priority = types.Int32Value(listenerRulePriorityDefault)
+		} else {
+			priority, err := strconv.ParseInt(p, 10, 32)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.ELBV2, create.ErrActionReading, dsNameListenerRules, aws.ToString(v.RuleArn), err),
+					err.Error(),
+				)
+				return
+			}
+			rule.Priority = types.Int32Value(int32(priority))
+			priorities[p] = aws.ToString(v.RuleArn)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	data.Priorities = flex.FlattenFrameworkStringValueMap(ctx, priorities)
+	data.Rule = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, rules)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type listenerRulesDataSourceModel struct {
+	framework.WithRegionModel
+	ListenerARN fwtypes.ARN                                             `tfsdk:"listener_arn"`
+	Priorities  types.Map                                               `tfsdk:"priorities"`
+	Rule        fwtypes.ListNestedObjectValueOf[listenerRulesRuleModel] `tfsdk:"rule"`
+}
+
+type listenerRulesRuleModel struct {
+	Action    fwtypes.ListNestedObjectValueOf[actionModel]       `tfsdk:"action"`
+	ARN       fwtypes.ARN                                        `tfsdk:"arn"`
+	Condition fwtypes.SetNestedObjectValueOf[ruleConditionModel] `tfsdk:"condition"`
+	IsDefault types.Bool                                         `tfsdk:"is_default"`
+	Priority  types.Int32                                        `tfsdk:"priority" autoflex:"-"`
+	Transform fwtypes.SetNestedObjectValueOf[transformModel]     `tfsdk:"transform"`
+}
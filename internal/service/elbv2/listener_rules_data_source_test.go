@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2ListenerRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_listener_rules.test"
+	resourceName := "aws_lb_listener_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckListenerRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "listener_arn", resourceName, "listener_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "priorities.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "priorities.100", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rule.*", map[string]string{
+						"is_default":       acctest.CtFalse,
+						names.AttrPriority: "100",
+						"action.#":         "1",
+						"action.0.type":    "forward",
+						"condition.#":      "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rule.*", map[string]string{
+						"is_default":       acctest.CtTrue,
+						names.AttrPriority: "99999",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccListenerRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_baseWithHTTPListener(rName), `
+data "aws_lb_listener_rules" "test" {
+  listener_arn = aws_lb_listener.test.arn
+
+  depends_on = [aws_lb_listener_rule.test]
+}
+
+resource "aws_lb_listener_rule" "test" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 100
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    host_header {
+      values = ["example.com"]
+    }
+  }
+}
+`)
+}
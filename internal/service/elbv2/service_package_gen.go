@@ -31,6 +31,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newListenerRulesDataSource,
+			TypeName: "aws_lb_listener_rules",
+			Name:     "Listener Rules",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
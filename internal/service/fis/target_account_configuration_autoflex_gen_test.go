@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package fis
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestResourceTargetAccountConfigurationModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, resourceTargetAccountConfigurationModel{}, []any{
+		fis.CreateTargetAccountConfigurationInput{},
+		fis.CreateTargetAccountConfigurationOutput{},
+		awstypes.TargetAccountConfiguration{},
+		fis.UpdateTargetAccountConfigurationInput{},
+		fis.UpdateTargetAccountConfigurationOutput{},
+	})
+}
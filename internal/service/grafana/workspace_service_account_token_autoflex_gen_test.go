@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package grafana
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/grafana"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/grafana/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestWorkspaceServiceAccountTokenResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, workspaceServiceAccountTokenResourceModel{}, []any{
+		grafana.CreateWorkspaceServiceAccountTokenInput{},
+		awstypes.ServiceAccountTokenSummary{},
+		grafana.DeleteWorkspaceServiceAccountTokenInput{},
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package inspector2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestFilterResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, filterResourceModel{}, []any{
+		inspector2.CreateFilterInput{},
+	})
+	autoflex.CheckFieldMappings(t, filterResourceModel{}, []any{
+		awstypes.Filter{},
+		inspector2.UpdateFilterInput{},
+	}, fwflex.WithFieldNamePrefix("Filter"))
+}
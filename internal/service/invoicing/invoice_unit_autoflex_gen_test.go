@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package invoicing
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestInvoiceUnitResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, invoiceUnitResourceModel{}, []any{
+		invoicing.CreateInvoiceUnitInput{},
+		invoicing.UpdateInvoiceUnitInput{},
+	})
+	autoflex.CheckFieldMappings(t, invoiceUnitResourceModel{}, []any{
+		invoicing.GetInvoiceUnitOutput{},
+	}, fwflex.WithFieldNamePrefix("InvoiceUnit"))
+}
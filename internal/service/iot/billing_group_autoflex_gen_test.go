@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package iot
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestBillingGroupResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, billingGroupResourceModel{}, []any{
+		iot.CreateBillingGroupInput{},
+		iot.DescribeBillingGroupOutput{},
+		iot.UpdateBillingGroupInput{},
+	}, flex.WithFieldNamePrefix("BillingGroup"))
+	autoflex.CheckFieldMappings(t, billingGroupResourceModel{}, []any{
+		iot.UpdateBillingGroupOutput{},
+	})
+}
@@ -19,12 +19,76 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// TestAliasCRUD exercises the resource's CRUD handlers and import against in-memory fake AWS APIs.
+func TestAliasCRUD(t *testing.T) {
+	t.Parallel()
+
+	const (
+		name    = "alias/test"
+		keyID1  = "1234abcd-12ab-34cd-56ef-1234567890ab"
+		keyID2  = "0987dcba-09fe-87dc-65ba-ab0987654321"
+		keyARN2 = "arn:" + fakeaws.Partition + ":kms:" + fakeaws.Region + ":" + fakeaws.AccountID + ":key/" + keyID2
+	)
+
+	ctx := t.Context()
+	client := fakeaws.NewServer(t, fakeaws.NewKMS()).AWSClient(ctx, t, tfkms.ServicePackage(ctx))
+	r := tfkms.ResourceAlias()
+
+	// Create.
+	d := r.TestResourceData()
+	d.Set(names.AttrName, name)
+	d.Set("target_key_id", keyID1)
+	if diags := r.CreateWithoutTimeout(ctx, d, client); diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if got, want := d.Id(), name; got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+	if got, want := d.Get(names.AttrARN).(string), "arn:"+fakeaws.Partition+":kms:"+fakeaws.Region+":"+fakeaws.AccountID+":"+name; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	// Update.
+	d.Set("target_key_id", keyID2)
+	if diags := r.UpdateWithoutTimeout(ctx, d, client); diags.HasError() {
+		t.Fatalf("Update: %v", diags)
+	}
+
+	// Import, which for this resource reads by ID.
+	d = r.TestResourceData()
+	d.SetId(name)
+	if diags := r.ReadWithoutTimeout(ctx, d, client); diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+	if got, want := d.Get("target_key_id").(string), keyID2; got != want {
+		t.Errorf("target_key_id = %q, want %q", got, want)
+	}
+	if got, want := d.Get("target_key_arn").(string), keyARN2; got != want {
+		t.Errorf("target_key_arn = %q, want %q", got, want)
+	}
+	if got, want := d.Get(names.AttrNamePrefix).(string), ""; got != want {
+		t.Errorf("name_prefix = %q, want %q", got, want)
+	}
+
+	// Delete.
+	if diags := r.DeleteWithoutTimeout(ctx, d, client); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	if diags := r.ReadWithoutTimeout(ctx, d, client); diags.HasError() {
+		t.Fatalf("Read after Delete: %v", diags)
+	}
+	if got := d.Id(); got != "" {
+		t.Errorf("ID after Delete = %q, want removed from state", got)
+	}
+}
+
 func TestAccKMSAlias_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var alias awstypes.AliasListEntry
@@ -16,6 +16,10 @@ var (
 	LFTagParseResourceID                = lfTagParseResourceID
 	FindOptInByID                       = findOptInByID
 	FindIdentityCenterConfigurationByID = findIdentityCenterConfigurationByID
+	FindPermissionsByPrincipal          = findPermissionsByPrincipal
+	GroupPermissionGrants               = groupPermissionGrants
+	PermissionChanges                   = permissionChanges
+	PermissionResourceKey               = permissionResourceKey
 
 	ValidPrincipal = validPrincipal
 
@@ -55,6 +55,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"lfTagPolicyMultiple":   testAccPermissions_lfTagPolicyMultiple,
 			"nonIAMPrincipals":      testAccPermissions_catalogResource_nonIAMPrincipals,
 		},
+		"PermissionsExclusive": {
+			acctest.CtBasic:  testAccPermissionsExclusive_basic,
+			"outOfBandGrant": testAccPermissionsExclusive_outOfBandGrant,
+			"scope":          testAccPermissionsExclusive_scope,
+		},
 		"PermissionsDataSource": {
 			acctest.CtBasic:    testAccPermissionsDataSource_basic,
 			"dataCellsFilter":  testAccPermissionsDataSource_dataCellsFilter,
@@ -0,0 +1,1060 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lakeformation_permissions_exclusive", name="Permissions Exclusive")
+func newPermissionsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &permissionsExclusiveResource{}, nil
+}
+
+const (
+	ResNamePermissionsExclusive = "Permissions Exclusive"
+)
+
+type permissionsExclusiveResource struct {
+	framework.ResourceWithModel[permissionsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *permissionsExclusiveResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	permissionsAttribute := func(required bool) schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringEnumType[awstypes.Permission](),
+			ElementType: fwtypes.StringEnumType[awstypes.Permission](),
+			Required:    required,
+			Optional:    !required,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCatalogID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			names.AttrPrincipal: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permission": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[permissionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"catalog_resource": schema.BoolAttribute{
+							Optional: true,
+						},
+						names.AttrPermissions:           permissionsAttribute(true),
+						"permissions_with_grant_option": permissionsAttribute(false),
+					},
+					Blocks: map[string]schema.Block{
+						"data_cells_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataCellsFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"table_catalog_id": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"data_location": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataLocationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						names.AttrDatabase: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[databaseModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lfTagModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValues: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+						"lf_tag_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lfTagExpressionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lfTagPolicyModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"expression_name": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrResourceType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrExpression: schema.SetNestedBlock{
+										CustomType: fwtypes.NewSetNestedObjectTypeOf[lfTagModel](ctx),
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrValues: schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"table": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[tableModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									"wildcard": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"table_with_columns": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[tableWithColumnsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"column_names": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+											setvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("excluded_column_names")),
+										},
+									},
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									"excluded_column_names": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrScope: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[permissionsScopeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDatabaseName: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("lf_tag_expression_name")),
+							},
+						},
+						"lf_tag_expression_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrTableName: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(names.AttrDatabaseName)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data permissionsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CatalogID.IsNull() || data.CatalogID.IsUnknown() {
+		data.CatalogID = fwflex.StringValueToFramework(ctx, r.Meta().AccountID(ctx))
+	}
+
+	scope, diags := data.Scope.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := data.expandGrants(ctx, scope)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncPermissions(ctx, data.CatalogID.ValueString(), data.Principal.ValueString(), scope, want); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNamePermissionsExclusive, data.Principal.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *permissionsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var data permissionsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set on import.
+	if data.CatalogID.IsNull() {
+		data.CatalogID = fwflex.StringValueToFramework(ctx, r.Meta().AccountID(ctx))
+	}
+
+	scope, diags := data.Scope.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPermissionsByPrincipal(ctx, conn, data.CatalogID.ValueString(), data.Principal.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionReading, ResNamePermissionsExclusive, data.Principal.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var permissions []permissionModel
+	for _, v := range groupPermissionGrants(scope.filter(out)) {
+		permission, diags := flattenPermissionGrant(ctx, v)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	data.Permissions = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, permissions)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *permissionsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state permissionsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Permissions.Equal(state.Permissions) || !plan.Scope.Equal(state.Scope) {
+		scope, diags := plan.Scope.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		want, diags := plan.expandGrants(ctx, scope)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.syncPermissions(ctx, plan.CatalogID.ValueString(), plan.Principal.ValueString(), scope, want); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNamePermissionsExclusive, plan.Principal.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *permissionsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrPrincipal), request, response)
+}
+
+// syncPermissions handles keeping the configured Lake Formation permissions
+// in sync with the permissions granted to the principal.
+//
+// Permissions defined on this resource but not granted to the principal
+// will be granted. Permissions granted to the principal within the scope
+// but not configured on this resource will be revoked.
+func (r *permissionsExclusiveResource) syncPermissions(ctx context.Context, catalogID, principal string, scope *permissionsScopeModel, want map[string]*permissionGrant) error {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	have, err := findPermissionsByPrincipal(ctx, conn, catalogID, principal)
+	if err != nil {
+		return err
+	}
+
+	revokes, grants, err := permissionChanges(catalogID, principal, scope.filter(have), want)
+	if err != nil {
+		return err
+	}
+
+	for _, input := range revokes {
+		key, _ := permissionResourceKey(input.Resource)
+
+		if _, err := conn.RevokePermissions(ctx, input); err != nil {
+			return fmt.Errorf("revoking Lake Formation permissions (%s): %w", key, err)
+		}
+	}
+
+	for _, input := range grants {
+		key, _ := permissionResourceKey(input.Resource)
+
+		if _, err := conn.GrantPermissions(ctx, input); err != nil {
+			return fmt.Errorf("granting Lake Formation permissions (%s): %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// permissionChanges returns the requests that revoke and then grant permissions so that
+// the principal's permissions `have` match `want`.
+func permissionChanges(catalogID, principal string, have []awstypes.PrincipalResourcePermissions, want map[string]*permissionGrant) ([]*lakeformation.RevokePermissionsInput, []*lakeformation.GrantPermissionsInput, error) {
+	var revokes []*lakeformation.RevokePermissionsInput
+	var grants []*lakeformation.GrantPermissionsInput
+
+	current := groupPermissionGrants(have)
+
+	for _, v := range have {
+		key, ok := permissionResourceKey(v.Resource)
+		if !ok {
+			return nil, nil, fmt.Errorf("principal (%s) has Lake Formation permissions (%s) on an unsupported resource type", principal, strings.Join(enum.Slice(v.Permissions...), ", "))
+		}
+
+		var wantPermissions, wantGrantable []awstypes.Permission
+		if grant, ok := want[key]; ok {
+			wantPermissions, wantGrantable = grant.permissions, grant.grantable
+		}
+
+		revoke := permissionsDifference(v.Permissions, wantPermissions)
+		// Revoking a permission also revokes its grant option.
+		revokeGrantable := permissionsDifference(v.PermissionsWithGrantOption, wantGrantable)
+
+		if len(revoke) == 0 && len(revokeGrantable) == 0 {
+			continue
+		}
+
+		// The grant option can't be revoked on its own, so the permission is revoked and granted again below.
+		revoke = permissionsUnion(revoke, revokeGrantable)
+
+		revokes = append(revokes, &lakeformation.RevokePermissionsInput{
+			CatalogId:                  aws.String(catalogID),
+			Permissions:                revoke,
+			PermissionsWithGrantOption: revokeGrantable,
+			Principal:                  v.Principal,
+			Resource:                   v.Resource,
+		})
+
+		if grant, ok := current[key]; ok {
+			grant.permissions = permissionsDifference(grant.permissions, revoke)
+			grant.grantable = permissionsDifference(grant.grantable, revoke)
+		}
+	}
+
+	keys := tfmaps.Keys(want)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		grant := want[key]
+
+		var havePermissions, haveGrantable []awstypes.Permission
+		if v, ok := current[key]; ok {
+			havePermissions, haveGrantable = v.permissions, v.grantable
+		}
+
+		add := permissionsDifference(grant.permissions, havePermissions)
+		addGrantable := permissionsDifference(grant.grantable, haveGrantable)
+
+		if len(add) == 0 && len(addGrantable) == 0 {
+			continue
+		}
+
+		grants = append(grants, &lakeformation.GrantPermissionsInput{
+			CatalogId: aws.String(catalogID),
+			// Permissions with grant option must be a subset of the granted permissions.
+			Permissions:                permissionsUnion(add, addGrantable),
+			PermissionsWithGrantOption: addGrantable,
+			Principal: &awstypes.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: grant.resource,
+		})
+	}
+
+	return revokes, grants, nil
+}
+
+func findPermissionsByPrincipal(ctx context.Context, conn *lakeformation.Client, catalogID, principal string) ([]awstypes.PrincipalResourcePermissions, error) {
+	input := lakeformation.ListPermissionsInput{
+		CatalogId: aws.String(catalogID),
+	}
+
+	if includePrincipalIdentifierInList(principal) {
+		input.Principal = &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		}
+	}
+
+	var output []awstypes.PrincipalResourcePermissions
+
+	pages := lakeformation.NewListPermissionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return output, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v.Principal == nil || aws.ToString(v.Principal.DataLakePrincipalIdentifier) != principal {
+				continue
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+// permissionResourceKey returns a canonical identifier for the Lake Formation resource
+// and whether the resource can be represented by a permissions_exclusive permission block.
+func permissionResourceKey(apiObject *awstypes.Resource) (string, bool) {
+	if apiObject == nil {
+		return "", false
+	}
+
+	switch {
+	case apiObject.Catalog != nil:
+		return "catalog", true
+	case apiObject.DataCellsFilter != nil:
+		return "data_cells_filter:" + aws.ToString(apiObject.DataCellsFilter.DatabaseName) + ":" + aws.ToString(apiObject.DataCellsFilter.TableName) + ":" + aws.ToString(apiObject.DataCellsFilter.Name), true
+	case apiObject.DataLocation != nil:
+		return "data_location:" + aws.ToString(apiObject.DataLocation.ResourceArn), true
+	case apiObject.Database != nil:
+		return "database:" + aws.ToString(apiObject.Database.Name), true
+	case apiObject.LFTag != nil:
+		return "lf_tag:" + lfTagKey(aws.ToString(apiObject.LFTag.TagKey), apiObject.LFTag.TagValues), true
+	case apiObject.LFTagExpression != nil:
+		return "lf_tag_expression:" + aws.ToString(apiObject.LFTagExpression.Name), true
+	case apiObject.LFTagPolicy != nil:
+		if v := apiObject.LFTagPolicy.ExpressionName; v != nil {
+			return "lf_tag_policy:" + string(apiObject.LFTagPolicy.ResourceType) + ":@" + aws.ToString(v), true
+		}
+
+		var expression []string
+		for _, v := range apiObject.LFTagPolicy.Expression {
+			expression = append(expression, lfTagKey(aws.ToString(v.TagKey), v.TagValues))
+		}
+		slices.Sort(expression)
+
+		return "lf_tag_policy:" + string(apiObject.LFTagPolicy.ResourceType) + ":" + strings.Join(expression, ";"), true
+	case apiObject.Table != nil:
+		if apiObject.Table.TableWildcard != nil {
+			return "table:" + aws.ToString(apiObject.Table.DatabaseName) + ":*", true
+		}
+
+		return "table:" + aws.ToString(apiObject.Table.DatabaseName) + ":" + aws.ToString(apiObject.Table.Name), true
+	case apiObject.TableWithColumns != nil:
+		table := aws.ToString(apiObject.TableWithColumns.DatabaseName) + ":" + aws.ToString(apiObject.TableWithColumns.Name)
+
+		if v := apiObject.TableWithColumns.ColumnWildcard; v != nil {
+			// Table-level SELECT grants are reported as all columns of the table.
+			if len(v.ExcludedColumnNames) == 0 {
+				return "table:" + table, true
+			}
+
+			return "table_with_columns:" + table + ":-" + sortedJoin(v.ExcludedColumnNames), true
+		}
+
+		return "table_with_columns:" + table + ":" + sortedJoin(apiObject.TableWithColumns.ColumnNames), true
+	}
+
+	return "", false
+}
+
+func lfTagKey(key string, values []string) string {
+	return key + "=" + sortedJoin(values)
+}
+
+func sortedJoin(s []string) string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+
+	return strings.Join(s, ",")
+}
+
+type permissionGrant struct {
+	grantable   []awstypes.Permission
+	permissions []awstypes.Permission
+	resource    *awstypes.Resource
+}
+
+// groupPermissionGrants merges the permissions granted on each Lake Formation resource.
+func groupPermissionGrants(apiObjects []awstypes.PrincipalResourcePermissions) map[string]*permissionGrant {
+	grants := make(map[string]*permissionGrant)
+
+	for _, v := range apiObjects {
+		key, ok := permissionResourceKey(v.Resource)
+		if !ok {
+			continue
+		}
+
+		grant, ok := grants[key]
+		if !ok {
+			grant = &permissionGrant{
+				resource: v.Resource,
+			}
+			grants[key] = grant
+		}
+
+		grant.permissions = permissionsUnion(grant.permissions, v.Permissions)
+		grant.grantable = permissionsUnion(grant.grantable, v.PermissionsWithGrantOption)
+	}
+
+	return grants
+}
+
+func permissionsDifference(s1, s2 []awstypes.Permission) []awstypes.Permission {
+	var output []awstypes.Permission
+
+	for _, v := range s1 {
+		if !slices.Contains(s2, v) && !slices.Contains(output, v) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+func permissionsUnion(s1, s2 []awstypes.Permission) []awstypes.Permission {
+	output := slices.Clone(s1)
+
+	for _, v := range s2 {
+		if !slices.Contains(output, v) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+func (m permissionsExclusiveResourceModel) expandGrants(ctx context.Context, scope *permissionsScopeModel) (map[string]*permissionGrant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	permissions, d := m.Permissions.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	grants := make(map[string]*permissionGrant)
+
+	for _, v := range permissions {
+		apiObject, d := v.expandResource(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		key, ok := permissionResourceKey(apiObject)
+		if !ok {
+			diags.AddAttributeError(path.Root("permission"), "Invalid permission", "Exactly one of catalog_resource, data_cells_filter, data_location, database, lf_tag, lf_tag_expression, lf_tag_policy, table or table_with_columns must be specified.")
+			return nil, diags
+		}
+
+		if !scope.contains(apiObject) {
+			diags.AddAttributeError(path.Root("permission"), "Invalid permission", fmt.Sprintf("Resource (%s) is not within the scope.", key))
+			return nil, diags
+		}
+
+		if _, ok := grants[key]; ok {
+			diags.AddAttributeError(path.Root("permission"), "Duplicate permission", fmt.Sprintf("Resource (%s) is specified in more than one permission block.", key))
+			return nil, diags
+		}
+
+		grants[key] = &permissionGrant{
+			grantable:   fwflex.ExpandFrameworkStringyValueSet[awstypes.Permission](ctx, v.PermissionsWithGrantOption),
+			permissions: fwflex.ExpandFrameworkStringyValueSet[awstypes.Permission](ctx, v.Permissions),
+			resource:    apiObject,
+		}
+	}
+
+	return grants, diags
+}
+
+func (m permissionModel) expandResource(ctx context.Context) (*awstypes.Resource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObject awstypes.Resource
+	var n int
+
+	if m.CatalogResource.ValueBool() {
+		apiObject.Catalog = &awstypes.CatalogResource{}
+		n++
+	}
+
+	if v, d := m.DataCellsFilter.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.DataCellsFilter = &awstypes.DataCellsFilterResource{
+			DatabaseName:   fwflex.StringFromFramework(ctx, v.DatabaseName),
+			Name:           fwflex.StringFromFramework(ctx, v.Name),
+			TableCatalogId: fwflex.StringFromFramework(ctx, v.TableCatalogID),
+			TableName:      fwflex.StringFromFramework(ctx, v.TableName),
+		}
+		n++
+	}
+
+	if v, d := m.DataLocation.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.DataLocation = &awstypes.DataLocationResource{
+			ResourceArn: fwflex.StringFromFramework(ctx, v.ARN),
+		}
+		n++
+	}
+
+	if v, d := m.Database.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.Database = &awstypes.DatabaseResource{
+			Name: fwflex.StringFromFramework(ctx, v.Name),
+		}
+		n++
+	}
+
+	if v, d := m.LFTag.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.LFTag = &awstypes.LFTagKeyResource{
+			TagKey:    fwflex.StringFromFramework(ctx, v.Key),
+			TagValues: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+		}
+		n++
+	}
+
+	if v, d := m.LFTagExpression.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.LFTagExpression = &awstypes.LFTagExpressionResource{
+			Name: fwflex.StringFromFramework(ctx, v.Name),
+		}
+		n++
+	}
+
+	if v, d := m.LFTagPolicy.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		expression, d := v.Expression.ToSlice(ctx)
+		diags.Append(d...)
+
+		// Exactly one of expression or expression_name must be specified.
+		if (len(expression) == 0) == v.ExpressionName.IsNull() {
+			return nil, diags
+		}
+
+		apiObject.LFTagPolicy = &awstypes.LFTagPolicyResource{
+			ExpressionName: fwflex.StringFromFramework(ctx, v.ExpressionName),
+			ResourceType:   v.ResourceType.ValueEnum(),
+		}
+		for _, v := range expression {
+			apiObject.LFTagPolicy.Expression = append(apiObject.LFTagPolicy.Expression, awstypes.LFTag{
+				TagKey:    fwflex.StringFromFramework(ctx, v.Key),
+				TagValues: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+			})
+		}
+		n++
+	}
+
+	if v, d := m.Table.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.Table = &awstypes.TableResource{
+			DatabaseName: fwflex.StringFromFramework(ctx, v.DatabaseName),
+		}
+		if v.Wildcard.ValueBool() {
+			apiObject.Table.TableWildcard = &awstypes.TableWildcard{}
+		} else {
+			apiObject.Table.Name = fwflex.StringFromFramework(ctx, v.Name)
+		}
+		n++
+	}
+
+	if v, d := m.TableWithColumns.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		apiObject.TableWithColumns = &awstypes.TableWithColumnsResource{
+			ColumnNames:  fwflex.ExpandFrameworkStringValueSet(ctx, v.ColumnNames),
+			DatabaseName: fwflex.StringFromFramework(ctx, v.DatabaseName),
+			Name:         fwflex.StringFromFramework(ctx, v.Name),
+		}
+		if v := fwflex.ExpandFrameworkStringValueSet(ctx, v.ExcludedColumnNames); len(v) > 0 {
+			apiObject.TableWithColumns.ColumnWildcard = &awstypes.ColumnWildcard{
+				ExcludedColumnNames: v,
+			}
+		}
+		n++
+	}
+
+	if n != 1 {
+		return nil, diags
+	}
+
+	return &apiObject, diags
+}
+
+func flattenPermissionGrant(ctx context.Context, grant *permissionGrant) (permissionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := permissionModel{
+		CatalogResource:            types.BoolNull(),
+		DataCellsFilter:            fwtypes.NewListNestedObjectValueOfNull[dataCellsFilterModel](ctx),
+		DataLocation:               fwtypes.NewListNestedObjectValueOfNull[dataLocationModel](ctx),
+		Database:                   fwtypes.NewListNestedObjectValueOfNull[databaseModel](ctx),
+		LFTag:                      fwtypes.NewListNestedObjectValueOfNull[lfTagModel](ctx),
+		LFTagExpression:            fwtypes.NewListNestedObjectValueOfNull[lfTagExpressionModel](ctx),
+		LFTagPolicy:                fwtypes.NewListNestedObjectValueOfNull[lfTagPolicyModel](ctx),
+		Permissions:                fwflex.FlattenFrameworkStringyValueSetOfStringEnum(ctx, grant.permissions),
+		PermissionsWithGrantOption: fwflex.FlattenFrameworkStringyValueSetOfStringEnum(ctx, grant.grantable),
+		Table:                      fwtypes.NewListNestedObjectValueOfNull[tableModel](ctx),
+		TableWithColumns:           fwtypes.NewListNestedObjectValueOfNull[tableWithColumnsModel](ctx),
+	}
+
+	switch apiObject := grant.resource; {
+	case apiObject.Catalog != nil:
+		data.CatalogResource = types.BoolValue(true)
+	case apiObject.DataCellsFilter != nil:
+		data.DataCellsFilter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dataCellsFilterModel{
+			DatabaseName:   fwflex.StringToFramework(ctx, apiObject.DataCellsFilter.DatabaseName),
+			Name:           fwflex.StringToFramework(ctx, apiObject.DataCellsFilter.Name),
+			TableCatalogID: fwflex.StringToFramework(ctx, apiObject.DataCellsFilter.TableCatalogId),
+			TableName:      fwflex.StringToFramework(ctx, apiObject.DataCellsFilter.TableName),
+		})
+	case apiObject.DataLocation != nil:
+		data.DataLocation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dataLocationModel{
+			ARN: fwtypes.ARNValue(aws.ToString(apiObject.DataLocation.ResourceArn)),
+		})
+	case apiObject.Database != nil:
+		data.Database = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &databaseModel{
+			Name: fwflex.StringToFramework(ctx, apiObject.Database.Name),
+		})
+	case apiObject.LFTag != nil:
+		data.LFTag = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lfTagModel{
+			Key:    fwflex.StringToFramework(ctx, apiObject.LFTag.TagKey),
+			Values: fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.LFTag.TagValues),
+		})
+	case apiObject.LFTagExpression != nil:
+		data.LFTagExpression = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lfTagExpressionModel{
+			Name: fwflex.StringToFramework(ctx, apiObject.LFTagExpression.Name),
+		})
+	case apiObject.LFTagPolicy != nil:
+		var expression []lfTagModel
+		for _, v := range apiObject.LFTagPolicy.Expression {
+			expression = append(expression, lfTagModel{
+				Key:    fwflex.StringToFramework(ctx, v.TagKey),
+				Values: fwflex.FlattenFrameworkStringValueSetOfString(ctx, v.TagValues),
+			})
+		}
+
+		lfTagPolicy := lfTagPolicyModel{
+			Expression:     fwtypes.NewSetNestedObjectValueOfNull[lfTagModel](ctx),
+			ExpressionName: fwflex.StringToFramework(ctx, apiObject.LFTagPolicy.ExpressionName),
+			ResourceType:   fwtypes.StringEnumValue(apiObject.LFTagPolicy.ResourceType),
+		}
+		if len(expression) > 0 {
+			lfTagPolicy.Expression = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, expression)
+		}
+
+		data.LFTagPolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lfTagPolicy)
+	case apiObject.Table != nil:
+		table := tableModel{
+			DatabaseName: fwflex.StringToFramework(ctx, apiObject.Table.DatabaseName),
+			Name:         types.StringNull(),
+			Wildcard:     types.BoolNull(),
+		}
+		if apiObject.Table.TableWildcard != nil {
+			table.Wildcard = types.BoolValue(true)
+		} else {
+			table.Name = fwflex.StringToFramework(ctx, apiObject.Table.Name)
+		}
+
+		data.Table = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &table)
+	case apiObject.TableWithColumns != nil:
+		if v := apiObject.TableWithColumns.ColumnWildcard; v != nil && len(v.ExcludedColumnNames) == 0 {
+			data.Table = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tableModel{
+				DatabaseName: fwflex.StringToFramework(ctx, apiObject.TableWithColumns.DatabaseName),
+				Name:         fwflex.StringToFramework(ctx, apiObject.TableWithColumns.Name),
+				Wildcard:     types.BoolNull(),
+			})
+			break
+		}
+
+		tableWithColumns := tableWithColumnsModel{
+			ColumnNames:         fwflex.FlattenFrameworkStringValueSetOfString(ctx, apiObject.TableWithColumns.ColumnNames),
+			DatabaseName:        fwflex.StringToFramework(ctx, apiObject.TableWithColumns.DatabaseName),
+			ExcludedColumnNames: fwtypes.NewSetValueOfNull[types.String](ctx),
+			Name:                fwflex.StringToFramework(ctx, apiObject.TableWithColumns.Name),
+		}
+		if v := apiObject.TableWithColumns.ColumnWildcard; v != nil {
+			tableWithColumns.ExcludedColumnNames = fwflex.FlattenFrameworkStringValueSetOfString(ctx, v.ExcludedColumnNames)
+		}
+
+		data.TableWithColumns = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tableWithColumns)
+	}
+
+	return data, diags
+}
+
+type permissionsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	CatalogID   types.String                                           `tfsdk:"catalog_id"`
+	Permissions fwtypes.SetNestedObjectValueOf[permissionModel]        `tfsdk:"permission"`
+	Principal   types.String                                           `tfsdk:"principal"`
+	Scope       fwtypes.ListNestedObjectValueOf[permissionsScopeModel] `tfsdk:"scope"`
+}
+
+type permissionModel struct {
+	CatalogResource            types.Bool                                             `tfsdk:"catalog_resource"`
+	DataCellsFilter            fwtypes.ListNestedObjectValueOf[dataCellsFilterModel]  `tfsdk:"data_cells_filter"`
+	DataLocation               fwtypes.ListNestedObjectValueOf[dataLocationModel]     `tfsdk:"data_location"`
+	Database                   fwtypes.ListNestedObjectValueOf[databaseModel]         `tfsdk:"database"`
+	LFTag                      fwtypes.ListNestedObjectValueOf[lfTagModel]            `tfsdk:"lf_tag"`
+	LFTagExpression            fwtypes.ListNestedObjectValueOf[lfTagExpressionModel]  `tfsdk:"lf_tag_expression"`
+	LFTagPolicy                fwtypes.ListNestedObjectValueOf[lfTagPolicyModel]      `tfsdk:"lf_tag_policy"`
+	Permissions                fwtypes.SetOfStringEnum[awstypes.Permission]           `tfsdk:"permissions"`
+	PermissionsWithGrantOption fwtypes.SetOfStringEnum[awstypes.Permission]           `tfsdk:"permissions_with_grant_option"`
+	Table                      fwtypes.ListNestedObjectValueOf[tableModel]            `tfsdk:"table"`
+	TableWithColumns           fwtypes.ListNestedObjectValueOf[tableWithColumnsModel] `tfsdk:"table_with_columns"`
+}
+
+type dataCellsFilterModel struct {
+	DatabaseName   types.String `tfsdk:"database_name"`
+	Name           types.String `tfsdk:"name"`
+	TableCatalogID types.String `tfsdk:"table_catalog_id"`
+	TableName      types.String `tfsdk:"table_name"`
+}
+
+type dataLocationModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type databaseModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type lfTagModel struct {
+	Key    types.String        `tfsdk:"key"`
+	Values fwtypes.SetOfString `tfsdk:"values"`
+}
+
+type lfTagExpressionModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type lfTagPolicyModel struct {
+	Expression     fwtypes.SetNestedObjectValueOf[lfTagModel] `tfsdk:"expression"`
+	ExpressionName types.String                               `tfsdk:"expression_name"`
+	ResourceType   fwtypes.StringEnum[awstypes.ResourceType]  `tfsdk:"resource_type"`
+}
+
+type tableModel struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	Name         types.String `tfsdk:"name"`
+	Wildcard     types.Bool   `tfsdk:"wildcard"`
+}
+
+type tableWithColumnsModel struct {
+	ColumnNames         fwtypes.SetOfString `tfsdk:"column_names"`
+	DatabaseName        types.String        `tfsdk:"database_name"`
+	ExcludedColumnNames fwtypes.SetOfString `tfsdk:"excluded_column_names"`
+	Name                types.String        `tfsdk:"name"`
+}
+
+type permissionsScopeModel struct {
+	DatabaseName        types.String `tfsdk:"database_name"`
+	LFTagExpressionName types.String `tfsdk:"lf_tag_expression_name"`
+	TableName           types.String `tfsdk:"table_name"`
+}
+
+// contains returns whether the Lake Formation resource is within the scope.
+// A nil scope contains all resources.
+func (m *permissionsScopeModel) contains(apiObject *awstypes.Resource) bool {
+	if m == nil {
+		return true
+	}
+
+	if apiObject == nil {
+		return false
+	}
+
+	if v := m.LFTagExpressionName; !v.IsNull() {
+		name := v.ValueString()
+
+		switch {
+		case apiObject.LFTagExpression != nil:
+			return aws.ToString(apiObject.LFTagExpression.Name) == name
+		case apiObject.LFTagPolicy != nil:
+			return aws.ToString(apiObject.LFTagPolicy.ExpressionName) == name
+		}
+
+		return false
+	}
+
+	database, table := m.DatabaseName.ValueString(), m.TableName.ValueString()
+	inScope := func(databaseName, tableName *string) bool {
+		return aws.ToString(databaseName) == database && (table == "" || aws.ToString(tableName) == table)
+	}
+
+	switch {
+	case apiObject.DataCellsFilter != nil:
+		return inScope(apiObject.DataCellsFilter.DatabaseName, apiObject.DataCellsFilter.TableName)
+	case apiObject.Database != nil:
+		return table == "" && aws.ToString(apiObject.Database.Name) == database
+	case apiObject.Table != nil:
+		return inScope(apiObject.Table.DatabaseName, apiObject.Table.Name)
+	case apiObject.TableWithColumns != nil:
+		return inScope(apiObject.TableWithColumns.DatabaseName, apiObject.TableWithColumns.Name)
+	}
+
+	return false
+}
+
+// filter returns the permissions on resources within the scope.
+func (m *permissionsScopeModel) filter(apiObjects []awstypes.PrincipalResourcePermissions) []awstypes.PrincipalResourcePermissions {
+	return slices.DeleteFunc(slices.Clone(apiObjects), func(v awstypes.PrincipalResourcePermissions) bool {
+		return !m.contains(v.Resource)
+	})
+}
@@ -0,0 +1,480 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_exclusive.test"
+	roleName := "aws_iam_role.test"
+	dbName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormationEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrPrincipal, roleName, names.AttrARN),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrCatalogID),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"database.#":                      "1",
+						"permissions.#":                   "3",
+						"permissions_with_grant_option.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.database.0.name", dbName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrPrincipal),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrPrincipal,
+			},
+		},
+	})
+}
+
+// A permission granted out of band should be revoked
+func testAccPermissionsExclusive_outOfBandGrant(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_exclusive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormationEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckPermissionsExclusiveGrantCatalogPermission(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// A permission granted out of band outside the scope should not be revoked
+func testAccPermissionsExclusive_scope(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_exclusive.test"
+	dbName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormationEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_scope(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "scope.0.database_name", dbName, names.AttrName),
+					testAccCheckPermissionsExclusiveGrantCatalogPermission(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_scope(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		output, err := tflakeformation.FindPermissionsByPrincipal(ctx, conn, rs.Primary.Attributes[names.AttrCatalogID], rs.Primary.Attributes[names.AttrPrincipal])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Lake Formation principal (%s) has %d permissions, expected %d", rs.Primary.Attributes[names.AttrPrincipal], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsExclusiveGrantCatalogPermission(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		input := lakeformation.GrantPermissionsInput{
+			CatalogId:   aws.String(rs.Primary.Attributes[names.AttrCatalogID]),
+			Permissions: []awstypes.Permission{awstypes.PermissionCreateDatabase},
+			Principal: &awstypes.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes[names.AttrPrincipal]),
+			},
+			Resource: &awstypes.Resource{
+				Catalog: &awstypes.CatalogResource{},
+			},
+		}
+		_, err := conn.GrantPermissions(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_exclusive" "test" {
+  principal = aws_iam_role.test.arn
+
+  permission {
+    permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccPermissionsExclusiveConfig_scope(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_exclusive" "test" {
+  principal = aws_iam_role.test.arn
+
+  scope {
+    database_name = aws_glue_catalog_database.test.name
+  }
+
+  permission {
+    permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func TestPermissionResourceKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input  *awstypes.Resource
+		want   string
+		wantOK bool
+	}{
+		"nil": {},
+		"catalog": {
+			input:  &awstypes.Resource{Catalog: &awstypes.CatalogResource{}},
+			want:   "catalog",
+			wantOK: true,
+		},
+		"database": {
+			input:  &awstypes.Resource{Database: &awstypes.DatabaseResource{Name: aws.String("db")}},
+			want:   "database:db",
+			wantOK: true,
+		},
+		"table": {
+			input:  &awstypes.Resource{Table: &awstypes.TableResource{DatabaseName: aws.String("db"), Name: aws.String("tbl")}},
+			want:   "table:db:tbl",
+			wantOK: true,
+		},
+		"table wildcard": {
+			input:  &awstypes.Resource{Table: &awstypes.TableResource{DatabaseName: aws.String("db"), TableWildcard: &awstypes.TableWildcard{}}},
+			want:   "table:db:*",
+			wantOK: true,
+		},
+		"table with all columns": {
+			input:  &awstypes.Resource{TableWithColumns: &awstypes.TableWithColumnsResource{DatabaseName: aws.String("db"), Name: aws.String("tbl"), ColumnWildcard: &awstypes.ColumnWildcard{}}},
+			want:   "table:db:tbl",
+			wantOK: true,
+		},
+		"table with excluded columns": {
+			input:  &awstypes.Resource{TableWithColumns: &awstypes.TableWithColumnsResource{DatabaseName: aws.String("db"), Name: aws.String("tbl"), ColumnWildcard: &awstypes.ColumnWildcard{ExcludedColumnNames: []string{"c2", "c1"}}}},
+			want:   "table_with_columns:db:tbl:-c1,c2",
+			wantOK: true,
+		},
+		"table with columns": {
+			input:  &awstypes.Resource{TableWithColumns: &awstypes.TableWithColumnsResource{DatabaseName: aws.String("db"), Name: aws.String("tbl"), ColumnNames: []string{"c1"}}},
+			want:   "table_with_columns:db:tbl:c1",
+			wantOK: true,
+		},
+		"lf tag values unordered": {
+			input:  &awstypes.Resource{LFTag: &awstypes.LFTagKeyResource{TagKey: aws.String("k"), TagValues: []string{"b", "a"}}},
+			want:   "lf_tag:k=a,b",
+			wantOK: true,
+		},
+		"lf tag policy expression unordered": {
+			input: &awstypes.Resource{LFTagPolicy: &awstypes.LFTagPolicyResource{
+				ResourceType: awstypes.ResourceTypeTable,
+				Expression: []awstypes.LFTag{
+					{TagKey: aws.String("k2"), TagValues: []string{"v"}},
+					{TagKey: aws.String("k1"), TagValues: []string{"v2", "v1"}},
+				},
+			}},
+			want:   "lf_tag_policy:TABLE:k1=v1,v2;k2=v",
+			wantOK: true,
+		},
+		"lf tag policy expression name": {
+			input:  &awstypes.Resource{LFTagPolicy: &awstypes.LFTagPolicyResource{ResourceType: awstypes.ResourceTypeTable, ExpressionName: aws.String("expr")}},
+			want:   "lf_tag_policy:TABLE:@expr",
+			wantOK: true,
+		},
+		"lf tag expression": {
+			input:  &awstypes.Resource{LFTagExpression: &awstypes.LFTagExpressionResource{Name: aws.String("expr")}},
+			want:   "lf_tag_expression:expr",
+			wantOK: true,
+		},
+		"data cells filter": {
+			input:  &awstypes.Resource{DataCellsFilter: &awstypes.DataCellsFilterResource{DatabaseName: aws.String("db"), TableName: aws.String("tbl"), Name: aws.String("filter")}},
+			want:   "data_cells_filter:db:tbl:filter",
+			wantOK: true,
+		},
+		"unsupported": {
+			input: &awstypes.Resource{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotOK := tflakeformation.PermissionResourceKey(testCase.input)
+
+			if got, want := gotOK, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("key = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPermissionChanges(t *testing.T) {
+	t.Parallel()
+
+	const (
+		catalogID = "123456789012"
+		principal = "arn:aws:iam::123456789012:role/example"
+	)
+	database := &awstypes.Resource{Database: &awstypes.DatabaseResource{Name: aws.String("db")}}
+	grant := func(resource *awstypes.Resource, permissions, grantable []awstypes.Permission) awstypes.PrincipalResourcePermissions {
+		return awstypes.PrincipalResourcePermissions{
+			Permissions:                permissions,
+			PermissionsWithGrantOption: grantable,
+			Principal:                  &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+			Resource:                   resource,
+		}
+	}
+	alter, drop := awstypes.PermissionAlter, awstypes.PermissionDrop
+
+	testCases := map[string]struct {
+		have        []awstypes.PrincipalResourcePermissions
+		want        []awstypes.PrincipalResourcePermissions
+		wantRevokes []*lakeformation.RevokePermissionsInput
+		wantGrants  []*lakeformation.GrantPermissionsInput
+		wantErr     bool
+	}{
+		"no changes": {
+			have: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter}, []awstypes.Permission{alter})},
+			want: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter}, []awstypes.Permission{alter})},
+		},
+		"grant": {
+			want: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter, drop}, []awstypes.Permission{alter})},
+			wantGrants: []*lakeformation.GrantPermissionsInput{{
+				CatalogId:                  aws.String(catalogID),
+				Permissions:                []awstypes.Permission{alter, drop},
+				PermissionsWithGrantOption: []awstypes.Permission{alter},
+				Principal:                  &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:                   database,
+			}},
+		},
+		"revoke": {
+			have: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter, drop}, nil)},
+			want: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter}, nil)},
+			wantRevokes: []*lakeformation.RevokePermissionsInput{{
+				CatalogId:   aws.String(catalogID),
+				Permissions: []awstypes.Permission{drop},
+				Principal:   &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:    database,
+			}},
+		},
+		"revoke grant option only": {
+			have: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter}, []awstypes.Permission{alter})},
+			want: []awstypes.PrincipalResourcePermissions{grant(database, []awstypes.Permission{alter}, nil)},
+			wantRevokes: []*lakeformation.RevokePermissionsInput{{
+				CatalogId:                  aws.String(catalogID),
+				Permissions:                []awstypes.Permission{alter},
+				PermissionsWithGrantOption: []awstypes.Permission{alter},
+				Principal:                  &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:                   database,
+			}},
+			wantGrants: []*lakeformation.GrantPermissionsInput{{
+				CatalogId:   aws.String(catalogID),
+				Permissions: []awstypes.Permission{alter},
+				Principal:   &awstypes.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal)},
+				Resource:    database,
+			}},
+		},
+		"unsupported resource": {
+			have:    []awstypes.PrincipalResourcePermissions{grant(&awstypes.Resource{}, []awstypes.Permission{alter}, nil)},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			revokes, grants, err := tflakeformation.PermissionChanges(catalogID, principal, testCase.have, tflakeformation.GroupPermissionGrants(testCase.want))
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			opts := cmpopts.IgnoreUnexported(lakeformation.RevokePermissionsInput{}, lakeformation.GrantPermissionsInput{}, awstypes.DataLakePrincipal{}, awstypes.Resource{}, awstypes.DatabaseResource{})
+			if diff := cmp.Diff(revokes, testCase.wantRevokes, opts); diff != "" {
+				t.Errorf("unexpected revokes diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(grants, testCase.wantGrants, opts); diff != "" {
+				t.Errorf("unexpected grants diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -54,6 +54,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Opt In",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newPermissionsExclusiveResource,
+			TypeName: "aws_lakeformation_permissions_exclusive",
+			Name:     "Permissions Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newResourceLFTagResource,
 			TypeName: "aws_lakeformation_resource_lf_tag",
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package lexv2models
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestBotLocaleResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, botLocaleResourceModel{}, []any{
+		lexmodelsv2.CreateBotLocaleInput{},
+		lexmodelsv2.DescribeBotLocaleOutput{},
+		lexmodelsv2.UpdateBotLocaleInput{},
+	})
+}
@@ -34,6 +34,7 @@ import (
 // @Tags(identifierAttribute="arn")
 // @Testing(importStateIdFunc="testAccAnomalyDetectorImportStateIDFunc")
 // @Testing(importStateIdAttribute="arn")
+// @Testing(preIdentityVersion="v6.27.0")
 // @Testing(importIgnore="enabled")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs;cloudwatchlogs.GetLogAnomalyDetectorOutput")
 // @Testing(existsTakesT=true)
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package logs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestAnomalyDetectorResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, anomalyDetectorResourceModel{}, []any{
+		cloudwatchlogs.CreateLogAnomalyDetectorInput{},
+		cloudwatchlogs.GetLogAnomalyDetectorOutput{},
+		cloudwatchlogs.UpdateLogAnomalyDetectorInput{},
+	})
+}
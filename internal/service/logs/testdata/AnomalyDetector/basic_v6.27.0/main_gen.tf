@@ -0,0 +1,31 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_cloudwatch_log_group" "test" {
+  count = 2
+  name  = "${var.rName}-${count.index}"
+}
+
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name           = var.rName
+  log_group_arn_list      = [aws_cloudwatch_log_group.test[0].arn]
+  anomaly_visibility_time = 7
+  evaluation_frequency    = "TEN_MIN"
+  enabled                 = "false"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+terraform {
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "6.27.0"
+    }
+  }
+}
+
+provider "aws" {}
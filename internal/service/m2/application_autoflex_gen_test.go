@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package m2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestApplicationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, applicationResourceModel{}, []any{
+		m2.CreateApplicationInput{},
+		m2.GetApplicationOutput{},
+	})
+}
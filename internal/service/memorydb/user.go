@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -54,11 +55,20 @@ func resourceUser() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringLenBetween(16, 128),
+							ConflictsWith: []string{"authentication_mode.0.passwords"},
+						},
 						"passwords": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MinItems: 1,
-							MaxItems: 2,
+							Type:          schema.TypeSet,
+							Optional:      true,
+							MinItems:      1,
+							MaxItems:      2,
+							ConflictsWith: []string{"authentication_mode.0.password_wo"},
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
@@ -90,10 +100,15 @@ func resourceUser() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateResourceName(userNameMaxLength),
 			},
+			flex.AttrWriteOnlyVersion: flex.WriteOnlyVersionSchema(),
 		},
+
+		CustomizeDiff: flex.WriteOnlyValuesCustomizeDiff(userPasswordWOPath),
 	}
 }
 
+var userPasswordWOPath = cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo")
+
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
@@ -105,8 +120,18 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		UserName:     aws.String(userName),
 	}
 
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, userPasswordWOPath)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
+
+		if passwordWO != "" {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		}
 	}
 
 	_, err := conn.CreateUser(ctx, input)
@@ -117,6 +142,11 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 	d.SetId(userName)
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, userPasswordWOPath)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
@@ -159,7 +189,13 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, userPasswordWOPath)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, flex.AttrWriteOnlyVersion) || flex.HasWriteOnlyValueChange(ctx, d, userPasswordWOPath) {
 		input := &memorydb.UpdateUserInput{
 			UserName: aws.String(d.Id()),
 		}
@@ -170,6 +206,10 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 
 		if v, ok := d.GetOk("authentication_mode"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.AuthenticationMode = expandAuthenticationMode(v.([]any)[0].(map[string]any))
+
+			if passwordWO != "" {
+				input.AuthenticationMode.Passwords = []string{passwordWO}
+			}
 		}
 
 		_, err := conn.UpdateUser(ctx, input)
@@ -183,6 +223,11 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, userPasswordWOPath)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
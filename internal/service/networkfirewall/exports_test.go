@@ -21,4 +21,6 @@ var (
 	FindRuleGroupByARN                  = findRuleGroupByARN
 	FindTLSInspectionConfigurationByARN = findTLSInspectionConfigurationByARN
 	FindVPCEndpointAssociationByARN     = findVPCEndpointAssociationByARN
+
+	SuricataHasUnescapedSemicolon = suricataHasUnescapedSemicolon
 )
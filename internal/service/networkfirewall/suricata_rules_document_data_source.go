@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// Suricata addresses: any, rule variables ($HOME_NET), IP set references (@REF), IP addresses, CIDR blocks and lists thereof.
+	suricataAddressRegex = regexache.MustCompile(`^!?(any|\$[A-Za-z_][0-9A-Za-z_]*|@[0-9A-Za-z_]+|[0-9A-Fa-f.:]+(/[0-9]{1,3})?|\[[^\s\[\]]+\])$`)
+	// Suricata ports: any, rule variables ($HTTP_PORTS), single ports, port ranges and lists thereof.
+	suricataPortRegex          = regexache.MustCompile(`^!?(any|\$[A-Za-z_][0-9A-Za-z_]*|[0-9]+|[0-9]*:[0-9]*|\[[^\s\[\]]+\])$`)
+	suricataOptionKeywordRegex = regexache.MustCompile(`^[0-9a-z_.]+$`)
+	suricataReservedKeywords   = []string{"msg", "rev", "sid"}
+	suricataMessageReplacer    = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `;`, `\;`)
+)
+
+// @FrameworkDataSource("aws_networkfirewall_suricata_rules_document", name="Suricata Rules Document")
+// @Region(overrideEnabled=false)
+func newSuricataRulesDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &suricataRulesDocumentDataSource{}, nil
+}
+
+type suricataRulesDocumentDataSource struct {
+	framework.DataSourceWithModel[suricataRulesDocumentDataSourceModel]
+}
+
+func (d *suricataRulesDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rules_string": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[suricataRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.StatefulAction](),
+							Required:   true,
+						},
+						names.AttrDestination: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(suricataAddressRegex, "must be a valid Suricata address"),
+							},
+						},
+						"destination_port": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(suricataPortRegex, "must be a valid Suricata port"),
+							},
+						},
+						"direction": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.StatefulRuleDirection](),
+							Optional:   true,
+						},
+						names.AttrMessage: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrProtocol: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.StatefulRuleProtocol](),
+							Required:   true,
+						},
+						"rev": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"sid": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4294967295),
+							},
+						},
+						names.AttrSource: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(suricataAddressRegex, "must be a valid Suricata address"),
+							},
+						},
+						"source_port": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(suricataPortRegex, "must be a valid Suricata port"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"option": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[suricataRuleOptionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"keyword": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(suricataOptionKeywordRegex, "must be a valid Suricata rule keyword"),
+											stringvalidator.NoneOf(suricataReservedKeywords...),
+										},
+									},
+									"settings": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *suricataRulesDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data suricataRulesDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sids := make(map[int64]int, len(rules))
+	lines := make([]string, 0, len(rules))
+
+	for i, rule := range rules {
+		sidPath := path.Root(names.AttrRule).AtListIndex(i).AtName("sid")
+		sid := rule.SID.ValueInt64()
+
+		if j, ok := sids[sid]; ok {
+			response.Diagnostics.AddAttributeError(sidPath, "Duplicate SID", fmt.Sprintf("SID %d is also used by rule %d. Each rule must have a unique SID.", sid, j))
+			continue
+		}
+		sids[sid] = i
+
+		line, err := rule.render(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrRule).AtListIndex(i), "Invalid Suricata rule", err.Error())
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.RulesString = types.StringValue(strings.Join(lines, "\n"))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type suricataRulesDocumentDataSourceModel struct {
+	Rules       fwtypes.ListNestedObjectValueOf[suricataRuleModel] `tfsdk:"rule"`
+	RulesString types.String                                       `tfsdk:"rules_string"`
+}
+
+type suricataRuleModel struct {
+	Action          fwtypes.StringEnum[awstypes.StatefulAction]              `tfsdk:"action"`
+	Destination     types.String                                             `tfsdk:"destination"`
+	DestinationPort types.String                                             `tfsdk:"destination_port"`
+	Direction       fwtypes.StringEnum[awstypes.StatefulRuleDirection]       `tfsdk:"direction"`
+	Message         types.String                                             `tfsdk:"message"`
+	Options         fwtypes.ListNestedObjectValueOf[suricataRuleOptionModel] `tfsdk:"option"`
+	Protocol        fwtypes.StringEnum[awstypes.StatefulRuleProtocol]        `tfsdk:"protocol"`
+	Revision        types.Int64                                              `tfsdk:"rev"`
+	SID             types.Int64                                              `tfsdk:"sid"`
+	Source          types.String                                             `tfsdk:"source"`
+	SourcePort      types.String                                             `tfsdk:"source_port"`
+}
+
+type suricataRuleOptionModel struct {
+	Keyword  types.String                      `tfsdk:"keyword"`
+	Settings fwtypes.ListValueOf[types.String] `tfsdk:"settings"`
+}
+
+// render returns the rule in Suricata rule syntax, e.g.
+//
+//	alert tcp $HOME_NET any -> any 443 (msg:"Example"; flow:to_server; sid:1; rev:1;)
+func (m suricataRuleModel) render(ctx context.Context) (string, error) {
+	direction := "->"
+	if m.Direction.ValueEnum() == awstypes.StatefulRuleDirectionAny {
+		direction = "<>"
+	}
+
+	options := make([]string, 0)
+
+	if v := m.Message.ValueString(); v != "" {
+		options = append(options, fmt.Sprintf(`msg:"%s";`, suricataMessageReplacer.Replace(v)))
+	}
+
+	ruleOptions, diags := m.Options.ToSlice(ctx)
+	if diags.HasError() {
+		return "", fmt.Errorf("reading rule options")
+	}
+
+	for _, option := range ruleOptions {
+		keyword := option.Keyword.ValueString()
+		settings := fwflex.ExpandFrameworkStringValueList(ctx, option.Settings)
+
+		if len(settings) == 0 {
+			options = append(options, keyword+";")
+			continue
+		}
+
+		for _, v := range settings {
+			if suricataHasUnescapedSemicolon(v) {
+				return "", fmt.Errorf("setting %q of option %q contains an unescaped semicolon", v, keyword)
+			}
+		}
+
+		options = append(options, fmt.Sprintf("%s:%s;", keyword, strings.Join(settings, ",")))
+	}
+
+	revision := int64(1)
+	if v := m.Revision.ValueInt64(); v > 0 {
+		revision = v
+	}
+
+	options = append(options, fmt.Sprintf("sid:%d;", m.SID.ValueInt64()), fmt.Sprintf("rev:%d;", revision))
+
+	return fmt.Sprintf("%s %s %s %s %s %s %s (%s)",
+		strings.ToLower(string(m.Action.ValueEnum())),
+		strings.ToLower(string(m.Protocol.ValueEnum())),
+		suricataValueOrAny(m.Source),
+		suricataValueOrAny(m.SourcePort),
+		direction,
+		suricataValueOrAny(m.Destination),
+		suricataValueOrAny(m.DestinationPort),
+		strings.Join(options, " "),
+	), nil
+}
+
+func suricataValueOrAny(v types.String) string {
+	if v := v.ValueString(); v != "" {
+		return v
+	}
+
+	return "any"
+}
+
+// suricataHasUnescapedSemicolon returns whether s contains a semicolon that isn't escaped.
+// A semicolon is escaped if it's preceded by an odd number of backslashes.
+func suricataHasUnescapedSemicolon(s string) bool {
+	backslashes := 0
+
+	for _, r := range s {
+		switch r {
+		case '\\':
+			backslashes++
+			continue
+		case ';':
+			if backslashes%2 == 0 {
+				return true
+			}
+		}
+
+		backslashes = 0
+	}
+
+	return false
+}
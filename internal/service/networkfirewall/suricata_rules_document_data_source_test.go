@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfnetworkfirewall "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSuricataHasUnescapedSemicolon(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    string
+		expected bool
+	}{
+		"empty":                                {value: "", expected: false},
+		"no semicolon":                         {value: `"example.com"`, expected: false},
+		"semicolon":                            {value: `a;b`, expected: true},
+		"leading semicolon":                    {value: `;`, expected: true},
+		"escaped semicolon":                    {value: `a\;b`, expected: false},
+		"escaped backslash and semicolon":      {value: `a\\;b`, expected: true},
+		"escaped backslash, escaped semicolon": {value: `a\\\;b`, expected: false},
+		"escaped semicolon then semicolon":     {value: `a\;b;`, expected: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfnetworkfirewall.SuricataHasUnescapedSemicolon(testCase.value), testCase.expected; got != want {
+				t.Errorf("SuricataHasUnescapedSemicolon(%q) = %t, want %t", testCase.value, got, want)
+			}
+		})
+	}
+}
+
+func TestAccNetworkFirewallSuricataRulesDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_networkfirewall_suricata_rules_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSuricataRulesDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rules_string", `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (msg:"Allow \"example\"\; domain"; tls.sni; content:"example.com"; startswith; nocase; endswith; flow:to_server,established; sid:1; rev:2;)
+drop ip any any <> [10.0.0.0/8,!10.1.0.0/16] any (sid:2; rev:1;)`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallSuricataRulesDocumentDataSource_duplicateSID(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSuricataRulesDocumentDataSourceConfig_duplicateSID,
+				ExpectError: regexache.MustCompile(`Duplicate SID`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallSuricataRulesDocumentDataSource_invalidAddress(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSuricataRulesDocumentDataSourceConfig_invalidAddress,
+				ExpectError: regexache.MustCompile(`must be a valid Suricata address`),
+			},
+		},
+	})
+}
+
+const testAccSuricataRulesDocumentDataSourceConfig_basic = `
+data "aws_networkfirewall_suricata_rules_document" "test" {
+  rule {
+    action           = "PASS"
+    protocol         = "TLS"
+    source           = "$HOME_NET"
+    destination      = "$EXTERNAL_NET"
+    destination_port = "443"
+    message          = "Allow \"example\"; domain"
+    sid              = 1
+    rev              = 2
+
+    option {
+      keyword = "tls.sni"
+    }
+
+    option {
+      keyword  = "content"
+      settings = ["\"example.com\""]
+    }
+
+    option {
+      keyword = "startswith"
+    }
+
+    option {
+      keyword = "nocase"
+    }
+
+    option {
+      keyword = "endswith"
+    }
+
+    option {
+      keyword  = "flow"
+      settings = ["to_server", "established"]
+    }
+  }
+
+  rule {
+    action      = "DROP"
+    protocol    = "IP"
+    direction   = "ANY"
+    destination = "[10.0.0.0/8,!10.1.0.0/16]"
+    sid         = 2
+  }
+}
+`
+
+const testAccSuricataRulesDocumentDataSourceConfig_duplicateSID = `
+data "aws_networkfirewall_suricata_rules_document" "test" {
+  rule {
+    action   = "PASS"
+    protocol = "TCP"
+    sid      = 1
+  }
+
+  rule {
+    action   = "DROP"
+    protocol = "TCP"
+    sid      = 1
+  }
+}
+`
+
+const testAccSuricataRulesDocumentDataSourceConfig_invalidAddress = `
+data "aws_networkfirewall_suricata_rules_document" "test" {
+  rule {
+    action   = "PASS"
+    protocol = "TCP"
+    source   = "10.0.0.0/8 any"
+    sid      = 1
+  }
+}
+`
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package networkflowmonitor
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkflowmonitor"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestMonitorResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, monitorResourceModel{}, []any{
+		networkflowmonitor.CreateMonitorInput{},
+		networkflowmonitor.GetMonitorOutput{},
+	})
+}
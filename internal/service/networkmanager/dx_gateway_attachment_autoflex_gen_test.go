@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestDirectConnectGatewayAttachmentResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, directConnectGatewayAttachmentResourceModel{}, []any{
+		networkmanager.CreateDirectConnectGatewayAttachmentInput{},
+	})
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package networkmonitor
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestProbeResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, probeResourceModel{}, []any{
+		awstypes.ProbeInput{},
+		networkmonitor.GetProbeOutput{},
+	})
+}
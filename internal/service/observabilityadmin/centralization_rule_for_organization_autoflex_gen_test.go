@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package observabilityadmin
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/observabilityadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestCentralizationRuleForOrganizationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, centralizationRuleForOrganizationResourceModel{}, []any{
+		observabilityadmin.CreateCentralizationRuleForOrganizationInput{},
+		observabilityadmin.CreateCentralizationRuleForOrganizationOutput{},
+		observabilityadmin.UpdateCentralizationRuleForOrganizationInput{},
+	})
+	autoflex.CheckFieldMappings(t, centralizationRuleForOrganizationResourceModel{}, []any{
+		observabilityadmin.GetCentralizationRuleForOrganizationOutput{},
+	}, fwflex.WithFieldNamePrefix("Centralization"))
+}
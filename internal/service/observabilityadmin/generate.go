@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -KVTValues -ListTags -ListTagsInIDElem=ResourceARN -UpdateTags -TagOp=TagResource -TagInIDElem=ResourceARN -UntagOp=UntagResource -UntagInTagsElem=TagKeys
+//go:generate go run ../../generate/autoflextests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package observabilityadmin
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package odb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/odb"
+	odbtypes "github.com/aws/aws-sdk-go-v2/service/odb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestCloudExadataInfrastructureResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, cloudExadataInfrastructureResourceModel{}, []any{
+		odb.CreateCloudExadataInfrastructureInput{},
+		odbtypes.CloudExadataInfrastructure{},
+		odb.UpdateCloudExadataInfrastructureInput{},
+	})
+}
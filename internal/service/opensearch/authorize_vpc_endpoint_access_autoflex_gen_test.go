@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package opensearch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestAuthorizeVPCEndpointAccessResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, authorizeVPCEndpointAccessResourceModel{}, []any{
+		opensearch.AuthorizeVpcEndpointAccessInput{},
+		opensearch.AuthorizeVpcEndpointAccessOutput{},
+		awstypes.AuthorizedPrincipal{},
+	})
+}
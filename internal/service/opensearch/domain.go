@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -115,6 +116,7 @@ func resourceDomain() *schema.Resource {
 			customdiff.ForceNewIfChange(names.AttrIPAddressType, func(_ context.Context, old, new, meta any) bool {
 				return (old.(string) == string(awstypes.IPAddressTypeDualstack)) && old.(string) != new.(string)
 			}),
+			flex.WriteOnlyValuesCustomizeDiff(cty.GetAttrPath("master_user_password_wo")),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -628,6 +630,13 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"master_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+			},
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -751,6 +760,7 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			flex.AttrWriteOnlyVersion: flex.WriteOnlyVersionSchema(),
 		},
 	}
 }
@@ -799,6 +809,16 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]any))
 	}
 
+	masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if masterUserPasswordWO != "" && input.AdvancedSecurityOptions != nil {
+		setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+	}
+
 	if v, ok := d.GetOk("aiml_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.AIMLOptions = expandAIMLOptionsInput(v.([]any)[0].(map[string]any))
 	}
@@ -970,6 +990,11 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, cty.GetAttrPath("master_user_password_wo"))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -1135,7 +1160,16 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Removing the write-only password leaves the current master user password unchanged.
+	hasMasterUserPasswordWOChange := masterUserPasswordWO != "" && flex.HasWriteOnlyValueChange(ctx, d, cty.GetAttrPath("master_user_password_wo"))
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, flex.AttrWriteOnlyVersion) || hasMasterUserPasswordWOChange {
 		name := d.Get(names.AttrDomainName).(string)
 		input := opensearch.UpdateDomainConfigInput{
 			DomainName: aws.String(name),
@@ -1157,8 +1191,12 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]any))
 		}
 
-		if d.HasChange("advanced_security_options") {
+		if d.HasChange("advanced_security_options") || hasMasterUserPasswordWOChange {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
+
+			if masterUserPasswordWO != "" {
+				setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+			}
 		}
 
 		if d.HasChange("aiml_options") {
@@ -1329,6 +1367,11 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	diags = append(diags, flex.SetWriteOnlyValues(ctx, d, cty.GetAttrPath("master_user_password_wo"))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return append(diags, resourceDomainRead(ctx, d, meta)...)
 }
 
@@ -54,6 +54,14 @@ func expandAdvancedSecurityOptions(m []any) *awstypes.AdvancedSecurityOptionsInp
 	return &config
 }
 
+func setMasterUserPassword(apiObject *awstypes.AdvancedSecurityOptionsInput, password string) {
+	if apiObject.MasterUserOptions == nil {
+		apiObject.MasterUserOptions = &awstypes.MasterUserOptions{}
+	}
+
+	apiObject.MasterUserOptions.MasterUserPassword = aws.String(password)
+}
+
 func expandAIMLOptionsInput(tfMap map[string]any) *awstypes.AIMLOptionsInput {
 	if tfMap == nil {
 		return nil
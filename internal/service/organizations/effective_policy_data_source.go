@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_organizations_effective_policy", name="Effective Policy")
+func dataSourceEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEffectivePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_invalid_accounts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"invalid_account_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EffectivePolicyType](),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.OrganizationsClient(ctx)
+
+	policyType := awstypes.EffectivePolicyType(d.Get("policy_type").(string))
+	targetID := c.AccountID(ctx)
+	if v, ok := d.GetOk("target_id"); ok {
+		targetID = v.(string)
+	}
+
+	policy, err := findEffectivePolicyByTwoPartKey(ctx, conn, policyType, targetID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Organizations Effective Policy", err))
+	}
+
+	d.SetId(targetID + "," + string(policyType))
+	d.Set("last_updated_timestamp", aws.ToTime(policy.LastUpdatedTimestamp).Format(time.RFC3339))
+	d.Set("policy_content", policy.PolicyContent)
+	d.Set("target_id", policy.TargetId)
+
+	if d.Get("include_invalid_accounts").(bool) {
+		input := &organizations.ListAccountsWithInvalidEffectivePolicyInput{
+			PolicyType: policyType,
+		}
+		accounts, err := findAccountsWithInvalidEffectivePolicy(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Organizations Accounts with invalid effective policy (%s): %s", policyType, err)
+		}
+
+		d.Set("invalid_account_ids", tfslices.ApplyToAll(accounts, func(v awstypes.Account) string {
+			return aws.ToString(v.Id)
+		}))
+	}
+
+	return diags
+}
+
+func findEffectivePolicyByTwoPartKey(ctx context.Context, conn *organizations.Client, policyType awstypes.EffectivePolicyType, targetID string) (*awstypes.EffectivePolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: policyType,
+		TargetId:   aws.String(targetID),
+	}
+
+	output, err := conn.DescribeEffectivePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.AWSOrganizationsNotInUseException](err) || errs.IsA[*awstypes.EffectivePolicyNotFoundException](err) || errs.IsA[*awstypes.TargetNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EffectivePolicy, nil
+}
+
+func findAccountsWithInvalidEffectivePolicy(ctx context.Context, conn *organizations.Client, input *organizations.ListAccountsWithInvalidEffectivePolicyInput) ([]awstypes.Account, error) {
+	var output []awstypes.Account
+
+	pages := organizations.NewListAccountsWithInvalidEffectivePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Accounts...)
+	}
+
+	return output, nil
+}
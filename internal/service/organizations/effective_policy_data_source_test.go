@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEffectivePolicyDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_organizations_effective_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", "TAG_POLICY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "policy_content"),
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "last_updated_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invalid_account_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectivePolicyDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  feature_set          = "ALL"
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  content = jsonencode({
+    tags = {
+      costcenter = {
+        tag_key = {
+          "@@assign" = "CostCenter"
+        }
+      }
+    }
+  })
+
+  name = %[1]q
+  type = "TAG_POLICY"
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = data.aws_caller_identity.current.account_id
+}
+
+data "aws_organizations_effective_policy" "test" {
+  depends_on = [aws_organizations_policy_attachment.test]
+
+  policy_type              = "TAG_POLICY"
+  include_invalid_accounts = true
+}
+`, rName)
+}
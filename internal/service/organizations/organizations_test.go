@@ -91,6 +91,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 			acctest.CtDisappears: testAccPolicyAttachment_disappears,
 			"Identity":           testAccOrganizationsPolicyAttachment_IdentitySerial,
 		},
+		"EffectivePolicyDataSource": {
+			acctest.CtBasic: testAccEffectivePolicyDataSource_basic,
+		},
 		"PolicyDataSource": {
 			"UnattachedPolicy": testAccPolicyDataSource_UnattachedPolicy,
 		},
@@ -51,6 +51,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Delegated Services",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  dataSourceEffectivePolicy,
+			TypeName: "aws_organizations_effective_policy",
+			Name:     "Effective Policy",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  dataSourceOrganization,
 			TypeName: "aws_organizations_organization",
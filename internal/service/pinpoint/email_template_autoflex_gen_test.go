@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package pinpoint
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestEmailTemplateResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, emailTemplateResourceModel{}, []any{
+		pinpoint.CreateEmailTemplateInput{},
+		pinpoint.UpdateEmailTemplateInput{},
+	}, flex.WithFieldNameSuffix("Request"))
+	autoflex.CheckFieldMappings(t, emailTemplateResourceModel{}, []any{
+		pinpoint.GetEmailTemplateOutput{},
+	}, flex.WithFieldNameSuffix("Response"))
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package pinpointsmsvoicev2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestPhoneNumberResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, phoneNumberResourceModel{}, []any{
+		pinpointsmsvoicev2.RequestPhoneNumberInput{},
+		awstypes.PhoneNumberInformation{},
+		pinpointsmsvoicev2.UpdatePhoneNumberInput{},
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package quicksight
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestIamPolicyAssignmentResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, iamPolicyAssignmentResourceModel{}, []any{
+		quicksight.CreateIAMPolicyAssignmentInput{},
+		awstypes.IAMPolicyAssignment{},
+		quicksight.UpdateIAMPolicyAssignmentInput{},
+	})
+}
@@ -34,6 +34,7 @@ const (
 )
 
 // @SDKResource("aws_ram_resource_share_accepter", name="Resource Share Accepter")
+// @AssumeRoleOverride
 func resourceResourceShareAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceShareAccepterCreate,
@@ -68,6 +68,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			TypeName: "aws_ram_resource_share_accepter",
 			Name:     "Resource Share Accepter",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			AssumeRole: inttypes.ServicePackageResourceAssumeRole{
+				IsOverrideEnabled: true,
+			},
 		},
 		{
 			Factory:  resourceSharingWithOrganization,
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package redshiftserverless
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestCustomDomainAssociationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, customDomainAssociationResourceModel{}, []any{
+		redshiftserverless.CreateCustomDomainAssociationInput{},
+		redshiftserverless.GetCustomDomainAssociationOutput{},
+	})
+}
@@ -41,6 +41,7 @@ import (
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/resiliencehub;resiliencehub.DescribeResiliencyPolicyOutput")
 // @Testing(importStateIdAttribute="arn")
+// @Testing(preIdentityVersion="v6.27.0")
 func newResiliencyPolicyResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resiliencyPolicyResource{}
 
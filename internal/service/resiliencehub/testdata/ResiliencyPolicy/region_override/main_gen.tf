@@ -0,0 +1,35 @@
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_resiliencehub_resiliency_policy" "test" {
+  name = var.rName
+
+  tier = "NotApplicable"
+
+  policy {
+    az {
+      rpo = "1h0m0s"
+      rto = "1h0m0s"
+    }
+    hardware {
+      rpo = "1h0m0s"
+      rto = "1h0m0s"
+    }
+    software {
+      rpo = "1h0m0s"
+      rto = "1h0m0s"
+    }
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
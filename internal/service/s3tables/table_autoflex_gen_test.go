@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package s3tables
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestTableResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, tableResourceModel{}, []any{
+		s3tables.CreateTableInput{},
+	})
+	autoflex.CheckFieldMappings(t, tableResourceModel{}, []any{
+		s3tables.GetTableOutput{},
+	}, fwflex.WithFieldNamePrefix("Table"))
+}
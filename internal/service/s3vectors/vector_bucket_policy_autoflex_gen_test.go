@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package s3vectors
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestVectorBucketPolicyResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, vectorBucketPolicyResourceModel{}, []any{
+		s3vectors.PutVectorBucketPolicyInput{},
+		s3vectors.GetVectorBucketPolicyOutput{},
+	})
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	findingsDefaultMaxResults = 1000
+	findingsPageSize          = 100 // Maximum value for GetFindings MaxResults.
+)
+
+// @SDKDataSource("aws_securityhub_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"compliance_status_counts": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+				},
+				"filters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     securityFindingFiltersResource(),
+				},
+				"finding_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"findings": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrAWSAccountID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"compliance_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrCreatedAt: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"generator_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"product_arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"record_state": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"resource_ids": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"severity_label": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"workflow_status": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"max_results": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      findingsDefaultMaxResults,
+					ValidateFunc: validation.IntBetween(1, 10000),
+				},
+				"severity_label_counts": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+				},
+				"workflow_status_counts": {
+					Type:     schema.TypeMap,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*conns.AWSClient)
+	conn := c.SecurityHubClient(ctx)
+
+	maxResults := d.Get("max_results").(int)
+	input := &securityhub.GetFindingsInput{
+		MaxResults: aws.Int32(int32(min(maxResults, findingsPageSize))),
+	}
+
+	if v, ok := d.GetOk("filters"); ok {
+		input.Filters = expandSecurityFindingFilters(v.([]any))
+	}
+
+	findings, err := findFindings(ctx, conn, input, maxResults)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Findings: %s", err)
+	}
+
+	complianceStatusCounts, severityLabelCounts, workflowStatusCounts := make(map[string]any), make(map[string]any), make(map[string]any)
+	increment := func(m map[string]any, key string) {
+		if key == "" {
+			return
+		}
+		if v, ok := m[key].(int); ok {
+			m[key] = v + 1
+		} else {
+			m[key] = 1
+		}
+	}
+	for _, v := range findings {
+		if v := v.Compliance; v != nil {
+			increment(complianceStatusCounts, string(v.Status))
+		}
+		if v := v.Severity; v != nil {
+			increment(severityLabelCounts, string(v.Label))
+		}
+		if v := v.Workflow; v != nil {
+			increment(workflowStatusCounts, string(v.Status))
+		}
+	}
+
+	d.SetId(c.Region(ctx))
+	d.Set("compliance_status_counts", complianceStatusCounts)
+	d.Set("finding_count", len(findings))
+	if err := d.Set("findings", flattenFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("severity_label_counts", severityLabelCounts)
+	d.Set("workflow_status_counts", workflowStatusCounts)
+
+	return diags
+}
+
+// findFindings returns at most maxResults findings matching the input.
+func findFindings(ctx context.Context, conn *securityhub.Client, input *securityhub.GetFindingsInput, maxResults int) ([]types.AwsSecurityFinding, error) {
+	var output []types.AwsSecurityFinding
+
+	pages := securityhub.NewGetFindingsPaginator(conn, input)
+	for pages.HasMorePages() && len(output) < maxResults {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	if len(output) > maxResults {
+		output = output[:maxResults]
+	}
+
+	return output, nil
+}
+
+func flattenFindings(apiObjects []types.AwsSecurityFinding) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrAWSAccountID: aws.ToString(apiObject.AwsAccountId),
+			names.AttrCreatedAt:    aws.ToString(apiObject.CreatedAt),
+			"generator_id":         aws.ToString(apiObject.GeneratorId),
+			names.AttrID:           aws.ToString(apiObject.Id),
+			"product_arn":          aws.ToString(apiObject.ProductArn),
+			"record_state":         string(apiObject.RecordState),
+			"resource_ids": tfslices.ApplyToAll(apiObject.Resources, func(v types.Resource) string {
+				return aws.ToString(v.Id)
+			}),
+			"title":      aws.ToString(apiObject.Title),
+			"updated_at": aws.ToString(apiObject.UpdatedAt),
+		}
+
+		if v := apiObject.Compliance; v != nil {
+			tfMap["compliance_status"] = string(v.Status)
+		}
+
+		if v := apiObject.Severity; v != nil {
+			tfMap["severity_label"] = string(v.Label)
+		}
+
+		if v := apiObject.Workflow; v != nil {
+			tfMap["workflow_status"] = string(v.Status)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
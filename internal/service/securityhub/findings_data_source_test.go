@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "finding_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "findings.#", dataSourceName, "finding_count"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "1000"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance_status_counts.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "severity_label_counts.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workflow_status_counts.%"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFindingsDataSourceConfig_basic = `
+resource "aws_securityhub_account" "test" {
+  enable_default_standards = false
+}
+
+data "aws_securityhub_findings" "test" {
+  filters {
+    record_state {
+      comparison = "EQUALS"
+      value      = "ACTIVE"
+    }
+
+    severity_label {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+
+    workflow_status {
+      comparison = "EQUALS"
+      value      = "NEW"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`
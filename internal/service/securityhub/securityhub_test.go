@@ -52,6 +52,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
 		},
+		"FindingsDataSource": {
+			acctest.CtBasic: testAccFindingsDataSource_basic,
+		},
 		"Insight": {
 			acctest.CtBasic:      testAccInsight_basic,
 			acctest.CtDisappears: testAccInsight_disappears,
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package securitylake
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestAwsLogSourceResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, awsLogSourceResourceModel{}, []any{
+		securitylake.CreateAwsLogSourceInput{},
+		securitylake.DeleteAwsLogSourceInput{},
+	})
+}
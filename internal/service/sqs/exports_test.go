@@ -21,4 +21,5 @@ var (
 	FIFOQueueNameSuffix                       = fifoQueueNameSuffix
 	QueueDeletedTimeout                       = queueDeletedTimeout
 	QueueNameFromURL                          = queueNameFromURL
+	SweepQueues                               = sweepQueues
 )
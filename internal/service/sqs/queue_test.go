@@ -11,6 +11,8 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -21,8 +23,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -37,6 +41,46 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+// TestSweepQueues exercises the sweeper against in-memory fake AWS APIs.
+func TestSweepQueues(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := t.Context()
+	client := fakeaws.NewServer(t, fakeaws.NewSQS()).AWSClient(ctx, t, tfsqs.ServicePackage(ctx))
+	conn := client.SQSClient(ctx)
+
+	for _, name := range []string{"test-1", "test-2"} {
+		if _, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String(name)}); err != nil {
+			t.Fatalf("CreateQueue: %s", err)
+		}
+	}
+
+	sweepables, err := tfsqs.SweepQueues(ctx, client)
+	if err != nil {
+		t.Fatalf("sweeping queues: %s", err)
+	}
+	if got, want := len(sweepables), 2; got != want {
+		t.Fatalf("got %d sweepable queues, want %d", got, want)
+	}
+
+	// Each queue's deletion waits for the deletion to be consistently reported.
+	if err := sweep.SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("deleting queues: %s", err)
+	}
+
+	output, err := conn.ListQueues(ctx, &sqs.ListQueuesInput{})
+	if err != nil {
+		t.Fatalf("ListQueues: %s", err)
+	}
+	if got := output.QueueUrls; len(got) != 0 {
+		t.Errorf("got queues %v after sweeping, want none", got)
+	}
+}
+
 func TestQueueNameFromURL(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package ssoadmin
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestApplicationResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, applicationResourceModel{}, []any{
+		ssoadmin.CreateApplicationInput{},
+		ssoadmin.DescribeApplicationOutput{},
+		ssoadmin.UpdateApplicationInput{},
+	})
+}
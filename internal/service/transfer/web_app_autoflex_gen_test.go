@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package transfer
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestWebAppResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, webAppResourceModel{}, []any{
+		transfer.CreateWebAppInput{},
+	})
+	autoflex.CheckFieldMappings(t, webAppResourceModel{}, []any{
+		awstypes.DescribedWebApp{},
+	}, fwflex.WithFieldNamePrefix("Described"))
+	autoflex.CheckFieldMappings(t, webAppResourceModel{}, []any{
+		transfer.UpdateWebAppInput{},
+	}, fwflex.WithIgnoredFieldNamesAppend("IdentityProviderDetails"))
+}
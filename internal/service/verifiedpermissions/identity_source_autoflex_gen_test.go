@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package verifiedpermissions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestIdentitySourceResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, identitySourceResourceModel{}, []any{
+		verifiedpermissions.CreateIdentitySourceInput{},
+		verifiedpermissions.GetIdentitySourceOutput{},
+	})
+	autoflex.CheckFieldMappings(t, identitySourceResourceModel{}, []any{
+		verifiedpermissions.UpdateIdentitySourceInput{},
+	}, flex.WithFieldNamePrefix("Update"))
+}
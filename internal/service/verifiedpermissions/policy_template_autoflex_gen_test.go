@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package verifiedpermissions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestPolicyTemplateResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, policyTemplateResourceModel{}, []any{
+		verifiedpermissions.CreatePolicyTemplateInput{},
+		verifiedpermissions.CreatePolicyTemplateOutput{},
+		verifiedpermissions.GetPolicyTemplateOutput{},
+		verifiedpermissions.UpdatePolicyTemplateInput{},
+		verifiedpermissions.UpdatePolicyTemplateOutput{},
+	})
+}
@@ -11,6 +11,7 @@ var (
 	ResourceWebACL                     = resourceWebACL
 	ResourceWebACLAssociation          = resourceWebACLAssociation
 	ResourceWebACLLoggingConfiguration = resourceWebACLLoggingConfiguration
+	ResourceWebACLRule                 = resourceWebACLRule
 	ResourceAPIKey                     = newAPIKeyResource
 	ResourceWebACLRuleGroupAssociation = newResourceWebACLRuleGroupAssociation
 
@@ -22,6 +23,7 @@ var (
 	FindRuleGroupByThreePartKey       = findRuleGroupByThreePartKey
 	FindWebACLByResourceARN           = findWebACLByResourceARN
 	FindWebACLByThreePartKey          = findWebACLByThreePartKey
+	FindWebACLRuleByFourPartKey       = findWebACLRuleByFourPartKey
 	IsCloudFrontDistributionARN       = isCloudFrontDistributionARN
 	ListRuleGroupsPages               = listRuleGroupsPages
 	ListWebACLsPages                  = listWebACLsPages
@@ -120,6 +120,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Web ACL Logging Configuration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceWebACLRule,
+			TypeName: "aws_wafv2_web_acl_rule",
+			Name:     "Web ACL Rule",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
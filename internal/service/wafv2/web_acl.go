@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -149,6 +151,11 @@ func resourceWebACL() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(1, 256),
 				},
+				"ignore_external_rules": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -365,7 +372,13 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set(names.AttrName, webACL.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(webACL.Name)))
 	if _, ok := d.GetOk("rule_json"); !ok {
-		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
+		configRules := expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+		rules := filterWebACLRules(webACL.Rules, configRules)
+		if d.Get("ignore_external_rules").(bool) {
+			rules = tfslices.Filter(rules, func(v awstypes.Rule) bool {
+				return !isExternalWebACLRule(v, configRules)
+			})
+		}
 		if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 		}
@@ -435,6 +448,31 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			rules = r
 		}
 
+		if d.Get("ignore_external_rules").(bool) {
+			output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
+			}
+
+			// Rules that are neither configured now nor were previously configured are managed elsewhere, e.g. by aws_wafv2_web_acl_rule.
+			o, _ := d.GetChange(names.AttrRule)
+			managedRules := slices.Concat(rules, expandWebACLRules(o.(*schema.Set).List()))
+			if o, _ := d.GetChange("rule_json"); o.(string) != "" {
+				r, err := expandWebACLRulesJSON(o.(string))
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "expanding WAFv2 WebACL JSON rule (%s): %s", d.Id(), err)
+				}
+				managedRules = append(managedRules, r...)
+			}
+
+			rules = append(rules, tfslices.Filter(output.WebACL.Rules, func(v awstypes.Rule) bool {
+				return isExternalWebACLRule(v, managedRules)
+			})...)
+			// The preserved rules are only current as of this read.
+			aclLockToken = aws.ToString(output.LockToken)
+		}
+
 		input := &wafv2.UpdateWebACLInput{
 			AssociationConfig:    expandAssociationConfig(d.Get("association_config").([]any)),
 			CaptchaConfig:        expandCaptchaConfig(d.Get("captcha_config").([]any)),
@@ -614,6 +652,13 @@ func filterWebACLRules(rules, configRules []awstypes.Rule) []awstypes.Rule {
 	return fr
 }
 
+// isExternalWebACLRule returns whether the specified rule is absent from the rules managed by an aws_wafv2_web_acl resource.
+func isExternalWebACLRule(rule awstypes.Rule, managedRules []awstypes.Rule) bool {
+	return !slices.ContainsFunc(managedRules, func(v awstypes.Rule) bool {
+		return aws.ToString(v.Name) == aws.ToString(rule.Name)
+	})
+}
+
 func findShieldRule(rules []awstypes.Rule) []awstypes.Rule {
 	pattern := `^ShieldMitigationRuleGroup_\d{12}_[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}_.*`
 	var sr []awstypes.Rule
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	webACLRuleResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_wafv2_web_acl_rule", name="Web ACL Rule")
+func resourceWebACLRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebACLRuleCreate,
+		ReadWithoutTimeout:   resourceWebACLRuleRead,
+		UpdateWithoutTimeout: resourceWebACLRuleUpdate,
+		DeleteWithoutTimeout: resourceWebACLRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrAction: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allow":     allowConfigSchema(),
+							"block":     blockConfigSchema(),
+							"captcha":   captchaConfigSchema(),
+							"challenge": challengeConfigSchema(),
+							"count":     countConfigSchema(),
+						},
+					},
+				},
+				"captcha_config":   outerCaptchaConfigSchema(),
+				"challenge_config": outerChallengeConfigSchema(),
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"override_action": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"count": emptySchema(),
+							"none":  emptySchema(),
+						},
+					},
+				},
+				names.AttrPriority: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"rule_label":        ruleLabelsSchema(),
+				"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
+				"visibility_config": visibilityConfigSchema(),
+				"web_acl_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			}
+		},
+	}
+}
+
+func resourceWebACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN, ruleName := d.Get("web_acl_arn").(string), d.Get(names.AttrName).(string)
+	id, err := flex.FlattenResourceId([]string{webACLARN, ruleName}, webACLRuleResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webACLID, webACLName, webACLScope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule := expandWebACLRuleResourceData(d)
+	err = updateWebACLRules(ctx, conn, webACLID, webACLName, webACLScope, d.Timeout(schema.TimeoutCreate), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		for _, v := range rules {
+			if aws.ToString(v.Name) == ruleName {
+				return nil, fmt.Errorf("rule with name %s already exists", ruleName)
+			}
+			if v.Priority == rule.Priority {
+				return nil, fmt.Errorf("rule with priority %d already exists", rule.Priority)
+			}
+		}
+
+		return append(rules, rule), nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WAFv2 WebACL Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), webACLRuleResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webACLARN, ruleName := parts[0], parts[1]
+	webACLID, webACLName, webACLScope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule, err := findWebACLRuleByFourPartKey(ctx, conn, webACLID, webACLName, webACLScope, ruleName)
+
+	if !d.IsNewResource() && retry.NotFound(err) {
+		log.Printf("[WARN] WAFv2 WebACL Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrAction, flattenRuleAction(rule.Action)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting action: %s", err)
+	}
+	if err := d.Set("captcha_config", flattenCaptchaConfig(rule.CaptchaConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting captcha_config: %s", err)
+	}
+	if err := d.Set("challenge_config", flattenChallengeConfig(rule.ChallengeConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting challenge_config: %s", err)
+	}
+	d.Set(names.AttrName, rule.Name)
+	if err := d.Set("override_action", flattenOverrideAction(rule.OverrideAction)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting override_action: %s", err)
+	}
+	d.Set(names.AttrPriority, rule.Priority)
+	if err := d.Set("rule_label", flattenRuleLabels(rule.RuleLabels)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule_label: %s", err)
+	}
+	if err := d.Set("statement", flattenWebACLRootStatement(rule.Statement)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statement: %s", err)
+	}
+	if err := d.Set("visibility_config", flattenVisibilityConfig(rule.VisibilityConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting visibility_config: %s", err)
+	}
+	d.Set("web_acl_arn", webACLARN)
+
+	return diags
+}
+
+func resourceWebACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLID, webACLName, webACLScope, err := parseWebACLARN(d.Get("web_acl_arn").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ruleName := d.Get(names.AttrName).(string)
+	rule := expandWebACLRuleResourceData(d)
+	err = updateWebACLRules(ctx, conn, webACLID, webACLName, webACLScope, d.Timeout(schema.TimeoutUpdate), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		found := false
+		for i, v := range rules {
+			if aws.ToString(v.Name) == ruleName {
+				rules[i] = rule
+				found = true
+			} else if v.Priority == rule.Priority {
+				return nil, fmt.Errorf("rule with priority %d already exists", rule.Priority)
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("rule %s not found", ruleName)
+		}
+
+		return rules, nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLID, webACLName, webACLScope, err := parseWebACLARN(d.Get("web_acl_arn").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting WAFv2 WebACL Rule: %s", d.Id())
+	ruleName := d.Get(names.AttrName).(string)
+	err = updateWebACLRules(ctx, conn, webACLID, webACLName, webACLScope, d.Timeout(schema.TimeoutDelete), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		return tfslices.Filter(rules, func(v awstypes.Rule) bool {
+			return aws.ToString(v.Name) != ruleName
+		}), nil
+	})
+
+	if retry.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandWebACLRuleResourceData(d *schema.ResourceData) awstypes.Rule {
+	return expandWebACLRule(map[string]any{
+		names.AttrAction:    d.Get(names.AttrAction),
+		"captcha_config":    d.Get("captcha_config"),
+		"challenge_config":  d.Get("challenge_config"),
+		names.AttrName:      d.Get(names.AttrName),
+		"override_action":   d.Get("override_action"),
+		names.AttrPriority:  d.Get(names.AttrPriority),
+		"rule_label":        d.Get("rule_label"),
+		"statement":         d.Get("statement"),
+		"visibility_config": d.Get("visibility_config"),
+	})
+}
+
+// updateWebACLRules applies f to the current rules of the specified web ACL and writes the result back.
+// The web ACL's lock token guards against concurrent modification; on conflict the web ACL is re-read and f re-applied.
+func updateWebACLRules(ctx context.Context, conn *wafv2.Client, id, name, scope string, timeout time.Duration, f func([]awstypes.Rule) ([]awstypes.Rule, error)) error {
+	_, err := tfresource.RetryWhenIsOneOf2[any, *awstypes.WAFOptimisticLockException, *awstypes.WAFUnavailableEntityException](ctx, timeout, func(ctx context.Context) (any, error) {
+		output, err := findWebACLByThreePartKey(ctx, conn, id, name, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules, err := f(output.WebACL.Rules)
+
+		if err != nil {
+			return nil, err
+		}
+
+		webACL := output.WebACL
+		input := wafv2.UpdateWebACLInput{
+			ApplicationConfig:            webACL.ApplicationConfig,
+			AssociationConfig:            webACL.AssociationConfig,
+			CaptchaConfig:                webACL.CaptchaConfig,
+			ChallengeConfig:              webACL.ChallengeConfig,
+			CustomResponseBodies:         webACL.CustomResponseBodies,
+			DataProtectionConfig:         webACL.DataProtectionConfig,
+			DefaultAction:                webACL.DefaultAction,
+			Id:                           aws.String(id),
+			LockToken:                    output.LockToken,
+			Name:                         aws.String(name),
+			OnSourceDDoSProtectionConfig: webACL.OnSourceDDoSProtectionConfig,
+			Rules:                        rules,
+			Scope:                        awstypes.Scope(scope),
+			TokenDomains:                 webACL.TokenDomains,
+			VisibilityConfig:             webACL.VisibilityConfig,
+		}
+
+		if aws.ToString(webACL.Description) != "" {
+			input.Description = webACL.Description
+		}
+
+		return conn.UpdateWebACL(ctx, &input)
+	})
+
+	return err
+}
+
+func findWebACLRuleByFourPartKey(ctx context.Context, conn *wafv2.Client, id, name, scope, ruleName string) (*awstypes.Rule, error) {
+	output, err := findWebACLByThreePartKey(ctx, conn, id, name, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output.WebACL.Rules, func(v awstypes.Rule) bool {
+		return aws.ToString(v.Name) == ruleName
+	}))
+}
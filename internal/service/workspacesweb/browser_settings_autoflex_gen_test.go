@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/autoflextests/main.go; DO NOT EDIT.
+
+package workspacesweb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/autoflex"
+)
+
+func TestBrowserSettingsResourceModelAutoFlex(t *testing.T) {
+	t.Parallel()
+
+	autoflex.CheckFieldMappings(t, browserSettingsResourceModel{}, []any{
+		workspacesweb.CreateBrowserSettingsInput{},
+		awstypes.BrowserSettings{},
+		workspacesweb.UpdateBrowserSettingsInput{},
+	})
+}
@@ -80,6 +80,29 @@ type TagPolicyConfig struct {
 	RequiredTags map[string]KeyValueTags
 }
 
+// PreventDestroyConfig contains tags that protect resources from deletion.
+type PreventDestroyConfig struct {
+	Tags KeyValueTags
+}
+
+// Matches returns those of the given tags whose keys and values match the configuration's Tags.
+// Any match means that the tagged resource must not be deleted.
+func (pc *PreventDestroyConfig) Matches(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	if pc == nil {
+		return result
+	}
+
+	for k, v := range pc.Tags {
+		if t, ok := tags[k]; ok && t.Equal(v) {
+			result[k] = t
+		}
+	}
+
+	return result
+}
+
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
 // The AWS Go SDK is split into multiple service packages, each service with
 // its own Go struct type representing a resource tag. To standardize logic
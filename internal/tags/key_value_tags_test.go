@@ -315,6 +315,83 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsPreventDestroyConfigMatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name                 string
+		tags                 KeyValueTags
+		preventDestroyConfig *PreventDestroyConfig
+		want                 map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			preventDestroyConfig: nil,
+			want:                 map[string]string{},
+		},
+		{
+			name: "empty config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			preventDestroyConfig: &PreventDestroyConfig{},
+			want:                 map[string]string{},
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			preventDestroyConfig: &PreventDestroyConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "matching key and value",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			preventDestroyConfig: &PreventDestroyConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key3": "value3",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "matching key different value",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			preventDestroyConfig: &PreventDestroyConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.preventDestroyConfig.Matches(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
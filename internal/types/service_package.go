@@ -44,6 +44,22 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceEventualConsistency represents resource-level eventual consistency information.
+type ServicePackageResourceEventualConsistency struct {
+	IsReadAfterCreateRetried bool     // Is a NotFound result retried on the first Read after Create?
+	UpdateAttributes         []string // A change to any of these attributes causes a NotFound result to be retried on the first Read after Update
+}
+
+// IsEnabled returns whether any eventual consistency handling is enabled.
+func (ec ServicePackageResourceEventualConsistency) IsEnabled() bool {
+	return ec.IsReadAfterCreateRetried || len(ec.UpdateAttributes) > 0
+}
+
+// ServicePackageResourceAssumeRole represents resource-level assume role information.
+type ServicePackageResourceAssumeRole struct {
+	IsOverrideEnabled bool // Is per-resource assume role override supported?
+}
+
 // ServicePackageAction represents a Terraform Plugin Framework action
 // implemented by a service package.
 type ServicePackageAction struct {
@@ -65,23 +81,26 @@ type ServicePackageEphemeralResource struct {
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
-	Factory  func(context.Context) (datasource.DataSourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
+	Factory    func(context.Context) (datasource.DataSourceWithConfigure, error)
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole ServicePackageResourceAssumeRole
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory             func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName            string
+	Name                string
+	Tags                unique.Handle[ServicePackageResourceTags]
+	Region              unique.Handle[ServicePackageResourceRegion]
+	AssumeRole          ServicePackageResourceAssumeRole
+	Identity            Identity
+	Import              FrameworkImport
+	EventualConsistency ServicePackageResourceEventualConsistency
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -96,23 +115,26 @@ type ServicePackageFrameworkListResource struct {
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
+	Factory    func() *schema.Resource
+	TypeName   string
+	Name       string
+	Tags       unique.Handle[ServicePackageResourceTags]
+	Region     unique.Handle[ServicePackageResourceRegion]
+	AssumeRole ServicePackageResourceAssumeRole
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   SDKv2Import
+	Factory             func() *schema.Resource
+	TypeName            string
+	Name                string
+	Tags                unique.Handle[ServicePackageResourceTags]
+	Region              unique.Handle[ServicePackageResourceRegion]
+	AssumeRole          ServicePackageResourceAssumeRole
+	Identity            Identity
+	Import              SDKv2Import
+	EventualConsistency ServicePackageResourceEventualConsistency
 }
 
 type ListResourceForSDK interface {
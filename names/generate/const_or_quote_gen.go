@@ -32,6 +32,7 @@ func ConstOrQuote(constant string) string {
 		"application_id":                "AttrApplicationID",
 		"apply_immediately":             "AttrApplyImmediately",
 		"association_id":                "AttrAssociationID",
+		"assume_role_arn":               "AttrAssumeRoleARN",
 		"attributes":                    "AttrAttributes",
 		"auto_minor_version_upgrade":    "AttrAutoMinorVersionUpgrade",
 		"availability_zone":             "AttrAvailabilityZone",
@@ -303,3 +303,10 @@ const (
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
+
+const (
+	ResourceTopLevelAssumeRoleARNAttributeDescription   = `ARN of an IAM role to assume when managing this resource. ` + topLevelAssumeRoleARNDefaultDescription
+	DataSourceTopLevelAssumeRoleARNAttributeDescription = `ARN of an IAM role to assume when reading this data source. ` + topLevelAssumeRoleARNDefaultDescription
+
+	topLevelAssumeRoleARNDefaultDescription = `Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+)
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed list.gtpl
+var listTmpl string
+
+//go:embed listfw.gtpl
+var listFrameworkTmpl string
+
+//go:embed listtest.gtpl
+var listTestTmpl string
+
+//go:embed maintf.gtpl
+var mainTFTmpl string
+
+//go:embed maintfquery.gtpl
+var mainTFQueryTmpl string
+
+//go:embed websitedoc.gtpl
+var websiteTmpl string
+
+type TemplateData struct {
+	Resource             string
+	ResourceAWS          string
+	ResourceLower        string
+	ResourceSnake        string
+	HumanFriendlyService string
+	IncludeComments      bool
+	IncludeTags          bool
+	PluginFramework      bool
+	SDKPackage           string
+	ServicePackage       string
+	Service              string
+	ServiceLower         string
+	AWSServiceName       string
+	HumanResourceName    string
+	ProviderResourceName string
+}
+
+func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceAWS:          capitalizeForAWS(resName),
+		ResourceLower:        convert.ToLowercasePrefix(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		IncludeTags:          tags,
+		PluginFramework:      pluginFramework,
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	tmpl := listTmpl
+	if pluginFramework {
+		tmpl = listFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s_list.go", snakeName)
+	if err = writeTemplate("newlist", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_list_test.go", snakeName)
+	if err = writeTemplate("listtest", tf, listTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test template: %w", err)
+	}
+
+	configDir := filepath.Join("testdata", resName, "list_basic")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("creating list resource test configuration directory (%s): %w", configDir, err)
+	}
+
+	if err = writeTemplate("maintf", filepath.Join(configDir, "main.tf"), mainTFTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test configuration template: %w", err)
+	}
+
+	if err = writeTemplate("maintfquery", filepath.Join(configDir, "main.tfquery.hcl"), mainTFQueryTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource test query template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "list-resources", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing list resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// AWS API structs use different capitalization than the provider standards
+func capitalizeForAWS(s string) string {
+	return strings.ReplaceAll(s, "VPC", "Vpc")
+}
@@ -12,11 +12,15 @@ import (
 	"os"
 	"path"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 )
 
@@ -131,11 +135,14 @@ func (m *migrator) migrate(outputFilename string) error {
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbModels := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelsWriter: &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
+		modelNames:   make(map[string]struct{}),
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -145,21 +152,49 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		DefaultCreateTimeout:          durationSpec(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:            durationSpec(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:          durationSpec(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:          durationSpec(emitter.DefaultDeleteTimeout),
+		EmitResourceUpdateSkeleton:    m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		HasTags:                       emitter.HasTopLevelTagsMap && (m.IsDataSource || emitter.HasTopLevelTagsAllMap),
+		HasTimeouts:                   emitter.HasTimeouts,
+		HasTopLevelARN:                emitter.HasTopLevelARN,
+		HasTopLevelRegion:             emitter.HasTopLevelRegion,
+		HumanName:                     naming.ToHumanName(m.Name),
+		ImportProviderFrameworkTypes:  emitter.ImportProviderFrameworkTypes,
+		LowerName:                     naming.ToLowerCamelCase(m.Name),
+		Models:                        sbModels.String(),
+		Name:                          m.Name,
+		PackageName:                   m.PackageName,
+		Schema:                        sbSchema.String(),
+		Struct:                        sbStruct.String(),
+		TFTypeName:                    m.TFTypeName,
+		UpgradeStateFromVersions:      make([]int, 0),
+		FrameworkPlanModifierPackages: make([]string, 0),
+		FrameworkValidatorsPackages:   make([]string, 0),
+		GoImports:                     make([]goImport, 0),
+	}
+
+	if service, err := data.LookupService(m.PackageName); err != nil {
+		m.warnf("looking up service package data for %q: %s", m.PackageName, err)
+
+		templateData.ClientName = "TODO"
+		templateData.SDKPackage = m.PackageName
+	} else {
+		templateData.ClientName = service.ProviderNameUpper()
+		templateData.IsGlobalService = service.IsGlobal()
+		templateData.SDKPackage = service.GoV2Package()
+	}
+
+	if !m.IsDataSource {
+		templateData.IdentityAnnotations = m.identityAnnotations()
+		templateData.EmitResourceImportByIdentity = len(templateData.IdentityAnnotations) > 0
+		templateData.EmitResourceImportState = !templateData.EmitResourceImportByIdentity && m.Resource.Importer != nil
+
+		for v := range m.Resource.SchemaVersion {
+			templateData.UpgradeStateFromVersions = append(templateData.UpgradeStateFromVersions, v)
+		}
 	}
 
 	for _, v := range emitter.FrameworkPlanModifierPackages {
@@ -178,38 +213,112 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		}
 	}
 
+	// Report the features that could not be translated.
+	templateData.Untranslated = append(templateData.Untranslated, emitter.Untranslated...)
+	if m.Resource.CustomizeDiff != nil {
+		templateData.Untranslated = append(templateData.Untranslated, "(resource): CustomizeDiff")
+	}
+	if m.Resource.MigrateState != nil {
+		templateData.Untranslated = append(templateData.Untranslated, "(resource): MigrateState")
+	}
+	if len(m.Resource.StateUpgraders) > 0 {
+		templateData.Untranslated = append(templateData.Untranslated, "(resource): StateUpgraders")
+	}
+	if templateData.EmitResourceImportState && (m.Resource.Importer.StateContext != nil || m.Resource.Importer.State != nil) { //nolint:staticcheck // SA1019: Deprecated importer functions must still be reported
+		templateData.Untranslated = append(templateData.Untranslated, "(resource): Importer")
+	}
+	for _, v := range templateData.Untranslated {
+		m.warnf("untranslated feature %s", v)
+	}
+
 	return templateData, nil
 }
 
+// identityAnnotations returns the resource identity annotations for the resource being migrated.
+// The annotations are derived from the Plugin SDK resource's identity schema.
+func (m *migrator) identityAnnotations() []string {
+	if m.Resource.Identity == nil || m.Resource.Identity.SchemaFunc == nil {
+		return nil
+	}
+
+	identitySchema := m.Resource.Identity.SchemaFunc()
+	identityAttributeNames := make([]string, 0)
+	for name := range identitySchema {
+		// The account ID and Region are added automatically.
+		if name == names.AttrAccountID || name == names.AttrRegion {
+			continue
+		}
+		identityAttributeNames = append(identityAttributeNames, name)
+	}
+	slices.Sort(identityAttributeNames)
+
+	switch {
+	case len(identityAttributeNames) == 0:
+		return []string{"@SingletonIdentity"}
+
+	case len(identityAttributeNames) == 1 && isARNAttribute(identityAttributeNames[0]):
+		var args []string
+		if name := identityAttributeNames[0]; name != names.AttrARN {
+			args = append(args, strconv.Quote(name))
+		}
+		// The generated schema always includes an "id" attribute.
+		args = append(args, `identityDuplicateAttributes="id"`)
+
+		return []string{fmt.Sprintf("@ArnIdentity(%s)", strings.Join(args, ", "))}
+
+	default:
+		annotations := make([]string, 0, len(identityAttributeNames))
+		for _, name := range identityAttributeNames {
+			if identitySchema[name].OptionalForImport {
+				annotations = append(annotations, fmt.Sprintf(`@IdentityAttribute(%q, optional="true")`, name))
+			} else {
+				annotations = append(annotations, fmt.Sprintf("@IdentityAttribute(%q)", name))
+			}
+		}
+
+		return annotations
+	}
+}
+
 func (m *migrator) infof(format string, a ...any) {
 	m.Generator.Infof(format, a...)
 }
 
+func (m *migrator) warnf(format string, a ...any) {
+	m.Generator.Warnf(format, a...)
+}
+
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HasTopLevelARN                bool
+	HasTopLevelRegion             bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
-	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelsWriter                  io.Writer // Nested model struct definitions.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Fields of the model struct currently being emitted.
+	Untranslated                  []string  // Features that could not be translated, as "path: feature".
+	modelNames                    map[string]struct{}
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
-	if _, ok := resource.Schema["id"]; ok {
+	s := resource.SchemaMap()
+
+	if _, ok := s["id"]; ok {
 		e.warnf("Explicit `id` attribute defined")
 	} else {
-		resource.Schema["id"] = &schema.Schema{
+		s["id"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: e.IsDataSource,
 			Computed: true,
@@ -220,22 +329,22 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, s)
 
 	if err != nil {
 		return err
@@ -279,6 +388,12 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			continue
 		}
 
+		// The top-level "region" attribute is injected by the provider.
+		if name == "region" && isTopLevelAttribute && isTopLevelRegionAttribute(property) {
+			e.HasTopLevelRegion = true
+			continue
+		}
+
 		if !emittedFieldName {
 			fprintf(e.SchemaWriter, "Attributes: map[string]schema.Attribute{\n")
 			emittedFieldName = true
@@ -293,23 +408,23 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 				fprintf(e.SchemaWriter, `// If the "id" attribute is composed from multiple attributes of the resource, use framework.IDAttributeDeprecatedNoReplacement()`+"\n")
 			}
 		}
+		if name == "arn" && isTopLevelAttribute {
+			e.HasTopLevelARN = true
+		}
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		if name == "id" && isTopLevelAttribute {
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
+			fprintf(e.StructWriter, "types.String")
 		} else {
 			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -332,12 +447,16 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
+
 		err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -357,6 +476,27 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
+	// Special handling for 'tags' and 'tags_all'.
+	if isTopLevelAttribute && property.Type == schema.TypeMap {
+		switch attributeName {
+		case "tags":
+			e.HasTopLevelTagsMap = true
+			fprintf(e.StructWriter, "tftags.Map")
+			if property.Optional {
+				fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			} else {
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
+			return nil
+
+		case "tags_all":
+			e.HasTopLevelTagsAllMap = true
+			fprintf(e.StructWriter, "tftags.Map")
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			return nil
+		}
+	}
+
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
 	//
@@ -364,51 +504,36 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		if isARNAttribute(attributeName) && !isComputedOnly {
 			e.ImportProviderFrameworkTypes = true
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -425,10 +550,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -438,10 +559,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -451,10 +568,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -463,52 +576,43 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
-
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+			elementType, err := primitiveElementType(path, typeName, v.Type)
+
+			if err != nil {
+				return err
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			if customType, valueType, ok := collectionOfPrimitiveType(typeName, v.Type); ok {
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+				fprintf(e.StructWriter, "%s", valueType)
+			} else {
+				fprintf(e.StructWriter, "types.%s", fwPlanModifierType)
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
-			fprintf(e.SchemaWriter, "ElementType:")
+			if typeName == "map" {
+				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			}
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
+			modelName, err := e.emitComputedOnlyModel(path, v.Schema)
+
+			if err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, ",\n")
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", nestedObjectType(typeName, modelName))
+			fprintf(e.SchemaWriter, "ElementType:types.ObjectType{\nAttrTypes: fwtypes.AttributeTypesMust[%s](ctx),\n},\n", modelName)
+			fprintf(e.StructWriter, "%s", nestedObjectValue(typeName, modelName))
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -604,10 +708,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	}
 
 	// Features that we can't (yet) migrate:
-
-	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
-		fprintf(e.SchemaWriter, "// TODO Validate,\n")
-	}
+	e.emitUntranslatedFeatures(path, property)
 
 	fprintf(e.SchemaWriter, "}")
 
@@ -616,6 +717,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
+// The nested block's model struct is emitted to the emitter's ModelsWriter.
 func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
@@ -625,50 +727,49 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	//
 	// Complex types.
 	//
-	case schema.TypeList:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
+	case schema.TypeList, schema.TypeSet:
+		var typeName string
+
+		switch v {
+		case schema.TypeList:
+			typeName = "list"
+
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
-				return err
-			}
-
-			fprintf(e.SchemaWriter, "},\n")
-
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
-		}
+		case schema.TypeSet:
+			typeName = "set"
 
-	case schema.TypeSet:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
+		}
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+		switch v := property.Elem.(type) {
+		case *schema.Resource:
+			modelName := e.newModelName(path)
+
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", fwPlanModifierType)
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", nestedObjectType(typeName, modelName))
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			fprintf(e.StructWriter, "%s", nestedObjectValue(typeName, modelName))
 
-			if err != nil {
+			if err := e.emitModel(modelName, func() error {
+				return e.emitAttributesAndBlocks(path, v.Schema)
+			}); err != nil {
 				return err
 			}
 
 			fprintf(e.SchemaWriter, "},\n")
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+			return unsupportedTypeError(path, fmt.Sprintf("(Block) %s of %T", typeName, v))
 		}
 
 	default:
@@ -726,71 +827,66 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
 
+	// Features that we can't (yet) migrate:
+	e.emitUntranslatedFeatures(path, property)
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
 }
 
-// emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
-// and emits the generated code to the emitter's Writer.
+// emitComputedOnlyModel generates the model struct for a Plugin SDK Computed-only nested block
+// and emits the generated code to the emitter's ModelsWriter.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitComputedOnlyModel(path []string, schema map[string]*schema.Schema) (string, error) {
+	modelName := e.newModelName(path)
+
 	names := make([]string, 0)
 	for name := range schema {
 		names = append(names, name)
 	}
 	slices.Sort(names)
 
-	fprintf(e.SchemaWriter, "types.ObjectType{\n")
+	err := e.emitModel(modelName, func() error {
+		for _, name := range names {
+			fieldType, err := e.computedOnlyFieldType(append(path, name), schema[name])
 
-	emittedFieldName := false
-	for _, name := range names {
-		property := schema[name]
+			if err != nil {
+				return err
+			}
 
-		if !emittedFieldName {
-			fprintf(e.SchemaWriter, "AttrTypes: map[string]attr.Type{\n")
-			emittedFieldName = true
-			e.ImportFrameworkAttr = true
+			fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), fieldType, name)
 		}
 
-		fprintf(e.SchemaWriter, "%q:", name)
-
-		err := e.emitComputedOnlyBlockProperty(append(path, name), property)
+		return nil
+	})
 
-		if err != nil {
-			return err
-		}
-	}
-	if emittedFieldName {
-		fprintf(e.SchemaWriter, "},\n")
+	if err != nil {
+		return "", err
 	}
 
-	fprintf(e.SchemaWriter, "}")
-
-	return nil
+	return modelName, nil
 }
 
-// emitComputedOnlyBlockProperty generates the Plugin Framework code for a Plugin SDK Computed-only nested block's property
-// and emits the generated code to the emitter's Writer.
+// computedOnlyFieldType returns the model field type for a Plugin SDK Computed-only nested block's property.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
+func (e *emitter) computedOnlyFieldType(path []string, property *schema.Schema) (string, error) {
 	switch v := property.Type; v {
 	//
 	// Primitive types.
 	//
 	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "types.BoolType,\n")
+		return "types.Bool", nil
 
 	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "types.Float64Type,\n")
+		return "types.Float64", nil
 
 	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "types.Int64Type,\n")
+		return "types.Int64", nil
 
 	case schema.TypeString:
-		fprintf(e.SchemaWriter, "types.StringType,\n")
+		return "types.String", nil
 
 	//
 	// Complex types.
@@ -800,60 +896,135 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 
 		switch v {
 		case schema.TypeList:
-			aggregateType = "types.ListType"
+			aggregateType = "types.List"
 			typeName = "list"
 		case schema.TypeMap:
-			aggregateType = "types.MapType"
+			aggregateType = "types.Map"
 			typeName = "map"
 		case schema.TypeSet:
-			aggregateType = "types.SetType"
+			aggregateType = "types.Set"
 			typeName = "set"
 		}
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			if _, err := primitiveElementType(path, typeName, v.Type); err != nil {
+				return "", err
+			}
 
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
+			if _, valueType, ok := collectionOfPrimitiveType(typeName, v.Type); ok {
+				return valueType, nil
+			}
 
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
+			return aggregateType, nil
 
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
+		case *schema.Resource:
+			if typeName == "map" {
+				break
+			}
 
-			case schema.TypeString:
-				elementType = "types.StringType"
+			modelName, err := e.emitComputedOnlyModel(path, v.Schema)
 
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.String()))
+			if err != nil {
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, "%s{ElemType:%s},\n", aggregateType, elementType)
+			return nestedObjectValue(typeName, modelName), nil
+		}
 
-		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s{ElemType:", aggregateType)
+		return "", unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, property.Elem))
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
+	default:
+		return "", unsupportedTypeError(path, v.String())
+	}
+}
 
-			fprintf(e.SchemaWriter, "},\n")
+// emitModel emits the model struct with the specified name to the emitter's ModelsWriter.
+// The struct's fields are those written to the emitter's StructWriter by the specified function.
+func (e *emitter) emitModel(modelName string, f func() error) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
-		}
+	err := f()
 
-	default:
-		return unsupportedTypeError(path, v.String())
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
 	}
 
+	fprintf(e.ModelsWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
+
 	return nil
 }
 
+// newModelName returns a unique model struct name for the nested block at the specified path.
+// The last path element is used if possible, otherwise parent path elements are prepended until the name is unique.
+func (e *emitter) newModelName(path []string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		modelName := naming.ToLowerCamelCase(strings.Join(path[i:], "_")) + "Model"
+
+		if _, ok := e.modelNames[modelName]; !ok {
+			e.modelNames[modelName] = struct{}{}
+
+			return modelName
+		}
+	}
+
+	for n := 2; ; n++ {
+		modelName := fmt.Sprintf("%sModel%d", naming.ToLowerCamelCase(strings.Join(path, "_")), n)
+
+		if _, ok := e.modelNames[modelName]; !ok {
+			e.modelNames[modelName] = struct{}{}
+
+			return modelName
+		}
+	}
+}
+
+// emitUntranslatedFeatures records any Plugin SDK property features that can't (yet) be migrated
+// and emits a TODO comment for each to the emitter's Writer.
+func (e *emitter) emitUntranslatedFeatures(path []string, property *schema.Schema) {
+	var features []string
+
+	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
+		features = append(features, "Validate")
+	}
+	if property.DiffSuppressFunc != nil {
+		features = append(features, "DiffSuppressFunc")
+	}
+	if property.DiffSuppressOnRefresh {
+		features = append(features, "DiffSuppressOnRefresh")
+	}
+	if property.StateFunc != nil {
+		features = append(features, "StateFunc")
+	}
+	if property.DefaultFunc != nil {
+		features = append(features, "DefaultFunc")
+	}
+	if property.Set != nil {
+		features = append(features, "Set (custom hash function)")
+	}
+	if len(property.ConflictsWith) > 0 {
+		features = append(features, fmt.Sprintf("ConflictsWith: %q", property.ConflictsWith))
+	}
+	if len(property.ExactlyOneOf) > 0 {
+		features = append(features, fmt.Sprintf("ExactlyOneOf: %q", property.ExactlyOneOf))
+	}
+	if len(property.AtLeastOneOf) > 0 {
+		features = append(features, fmt.Sprintf("AtLeastOneOf: %q", property.AtLeastOneOf))
+	}
+	if len(property.RequiredWith) > 0 {
+		features = append(features, fmt.Sprintf("RequiredWith: %q", property.RequiredWith))
+	}
+
+	for _, feature := range features {
+		fprintf(e.SchemaWriter, "// TODO %s,\n", feature)
+		e.Untranslated = append(e.Untranslated, fmt.Sprintf("%s: %s", strings.Join(path, "/"), feature))
+	}
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...any) {
 	e.Generator.Warnf(format, a...)
@@ -897,29 +1068,116 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// isARNAttribute returns whether or not the specified attribute name is that of an ARN.
+func isARNAttribute(name string) bool {
+	return name == "arn" || strings.HasSuffix(name, "_arn")
+}
+
+// isTopLevelRegionAttribute returns whether or not the specified property is the top-level "region" attribute injected by the provider.
+func isTopLevelRegionAttribute(property *schema.Schema) bool {
+	return property.Description == names.ResourceTopLevelRegionAttributeDescription
+}
+
+// primitiveElementType returns the Plugin Framework element type for a collection of Plugin SDK primitives.
+func primitiveElementType(path []string, typeName string, typ schema.ValueType) (string, error) {
+	switch typ {
+	case schema.TypeBool:
+		return "types.BoolType", nil
+	case schema.TypeFloat:
+		return "types.Float64Type", nil
+	case schema.TypeInt:
+		return "types.Int64Type", nil
+	case schema.TypeString:
+		return "types.StringType", nil
+	default:
+		return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, typ.String()))
+	}
+}
+
+// collectionOfPrimitiveType returns the provider's custom type and value type for a collection of Plugin SDK primitives, if one exists.
+func collectionOfPrimitiveType(typeName string, typ schema.ValueType) (string, string, bool) {
+	switch {
+	case typeName == "list" && typ == schema.TypeString:
+		return "fwtypes.ListOfStringType", "fwtypes.ListOfString", true
+	case typeName == "list" && typ == schema.TypeInt:
+		return "fwtypes.ListOfInt64Type", "fwtypes.ListOfInt64", true
+	case typeName == "set" && typ == schema.TypeString:
+		return "fwtypes.SetOfStringType", "fwtypes.SetOfString", true
+	case typeName == "map" && typ == schema.TypeString:
+		return "fwtypes.MapOfStringType", "fwtypes.MapOfString", true
+	default:
+		return "", "", false
+	}
+}
+
+// nestedObjectType returns the provider's custom type for a list or set of the specified nested model.
+func nestedObjectType(typeName, modelName string) string {
+	if typeName == "set" {
+		return fmt.Sprintf("fwtypes.NewSetNestedObjectTypeOf[%s](ctx)", modelName)
+	}
+
+	return fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", modelName)
+}
+
+// nestedObjectValue returns the provider's value type for a list or set of the specified nested model.
+func nestedObjectValue(typeName, modelName string) string {
+	if typeName == "set" {
+		return fmt.Sprintf("fwtypes.SetNestedObjectValueOf[%s]", modelName)
+	}
+
+	return fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+}
+
+// durationSpec returns a human-friendly Go expression for the specified duration.
+func durationSpec(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return ""
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	ClientName                    string // e.g. SQS
+	DefaultCreateTimeout          string
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	EmitResourceImportByIdentity  bool
 	EmitResourceImportState       bool
-	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
+	HasTags                       bool
 	HasTimeouts                   bool
-	ImportFrameworkAttr           bool
+	HasTopLevelARN                bool
+	HasTopLevelRegion             bool
+	HumanName                     string // e.g. Security Group
+	IdentityAnnotations           []string
 	ImportProviderFrameworkTypes  bool
+	IsGlobalService               bool
+	LowerName                     string // e.g. instance
+	Models                        string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	SDKPackage                    string // e.g. ec2
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	Untranslated                  []string
+	UpgradeStateFromVersions      []int
 }
 
 //go:embed datasource.gtpl
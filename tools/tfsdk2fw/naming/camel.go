@@ -62,3 +62,46 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism (e.g. "ARN" or "ID") is lowercased in its entirety.
+func ToLowerCamelCase(s string) string {
+	s = ToCamelCase(s)
+
+	i := 0
+	for i < len(s) && isCapitalLetter(s[i]) {
+		i++
+	}
+
+	switch {
+	case i == 0:
+		return s
+	case i == 1, i == len(s):
+		return strings.ToLower(s[:i]) + s[i:]
+	default:
+		// "ARNFormat" -> "arnFormat".
+		return strings.ToLower(s[:i-1]) + s[i-1:]
+	}
+}
+
+// ToHumanName converts a CamelCase string to space-separated words.
+// Initialisms (e.g. "VPC") are kept together.
+func ToHumanName(s string) string {
+	c := strings.Builder{}
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if i > 0 && isCapitalLetter(ch) {
+			prev := s[i-1]
+			// "SecurityGroup" -> "Security Group", "VPCEndpoint" -> "VPC Endpoint".
+			if isLowercaseLetter(prev) || isNumeric(prev) || (isCapitalLetter(prev) && i+1 < len(s) && isLowercaseLetter(s[i+1])) {
+				c.WriteByte(' ')
+			}
+		}
+
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
@@ -67,3 +67,96 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToLowerCamelCase(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "description",
+			ExpectedValue: "description",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "health_check_config",
+			ExpectedValue: "healthCheckConfig",
+		},
+		{
+			TestName:      "ID",
+			Value:         "id",
+			ExpectedValue: "id",
+		},
+		{
+			TestName:      "ARN",
+			Value:         "arn",
+			ExpectedValue: "arn",
+		},
+		{
+			TestName:      "ARN suffix",
+			Value:         "target_arn",
+			ExpectedValue: "targetARN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToLowerCamelCase(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}
+
+func TestToHumanName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Queue",
+			ExpectedValue: "Queue",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "SecurityGroupRule",
+			ExpectedValue: "Security Group Rule",
+		},
+		{
+			TestName:      "leading initialism",
+			Value:         "VPCEndpoint",
+			ExpectedValue: "VPC Endpoint",
+		},
+		{
+			TestName:      "trailing initialism",
+			Value:         "InstanceARN",
+			ExpectedValue: "Instance ARN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToHumanName(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}